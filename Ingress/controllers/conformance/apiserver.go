@@ -0,0 +1,160 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+const (
+	apiPrefix        = "/api/v1/"
+	extensionsPrefix = "/apis/extensions/v1beta1/"
+)
+
+// NewAPIServer returns a fake apiserver that serves the objects of a Case.
+// It supports get and list (optionally in a namespace) of ingresses, services
+// and endpoints. Updates are accepted and returned without changes so
+// controllers can update the status of the Ingress. Watches return an empty
+// stream.
+func NewAPIServer(c Case) *httptest.Server {
+	return httptest.NewServer(&apiServer{c})
+}
+
+type apiServer struct {
+	c Case
+}
+
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var path string
+	switch {
+	case strings.HasPrefix(r.URL.Path, apiPrefix):
+		path = strings.TrimPrefix(r.URL.Path, apiPrefix)
+	case strings.HasPrefix(r.URL.Path, extensionsPrefix):
+		path = strings.TrimPrefix(r.URL.Path, extensionsPrefix)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported path %v", r.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	if strings.HasPrefix(path, "watch/") || r.URL.Query().Get("watch") == "true" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var ns, resource, name string
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if parts[0] == "namespaces" && len(parts) > 2 {
+		ns = parts[1]
+		parts = parts[2:]
+	}
+	resource = parts[0]
+	if len(parts) > 1 {
+		name = parts[1]
+	}
+
+	switch r.Method {
+	case "GET":
+	case "PUT", "POST":
+		// return the same object
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+		return
+	default:
+		http.Error(w, fmt.Sprintf("unsupported method %v", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	obj, err := s.get(resource, ns, name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	codec := testapi.Default.Codec()
+	if resource == "ingresses" {
+		codec = testapi.Extensions.Codec()
+	}
+	data, err := runtime.Encode(codec, obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// get returns the object ns/name of the resource or a list if name is empty
+func (s *apiServer) get(resource, ns, name string) (runtime.Object, error) {
+	matches := func(meta api.ObjectMeta) bool {
+		return (ns == "" || meta.Namespace == ns) && (name == "" || meta.Name == name)
+	}
+
+	var items []runtime.Object
+	switch resource {
+	case "ingresses":
+		list := &extensions.IngressList{}
+		for _, ing := range s.c.Ingresses {
+			if matches(ing.ObjectMeta) {
+				list.Items = append(list.Items, *ing)
+				items = append(items, ing)
+			}
+		}
+		if name == "" {
+			return list, nil
+		}
+	case "services":
+		list := &api.ServiceList{}
+		for _, svc := range s.c.Services {
+			if matches(svc.ObjectMeta) {
+				list.Items = append(list.Items, *svc)
+				items = append(items, svc)
+			}
+		}
+		if name == "" {
+			return list, nil
+		}
+	case "endpoints":
+		list := &api.EndpointsList{}
+		for _, ep := range s.c.Endpoints {
+			if matches(ep.ObjectMeta) {
+				list.Items = append(list.Items, *ep)
+				items = append(items, ep)
+			}
+		}
+		if name == "" {
+			return list, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resource %v", resource)
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%v %v/%v not found", resource, ns, name)
+	}
+	return items[0], nil
+}
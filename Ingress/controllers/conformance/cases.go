@@ -0,0 +1,273 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+const (
+	otherNamespace = "team"
+	servicePort    = 80
+)
+
+var (
+	// creation time of the first Ingress of a Case
+	baseTime = time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	fooSvc     = Backend(api.NamespaceDefault, "foo-svc", servicePort)
+	barSvc     = Backend(api.NamespaceDefault, "bar-svc", servicePort)
+	rootSvc    = Backend(api.NamespaceDefault, "root-svc", servicePort)
+	defaultSvc = Backend(api.NamespaceDefault, "default-svc", servicePort)
+	teamFooSvc = Backend(otherNamespace, "foo-svc", servicePort)
+)
+
+// Case is an entry in the conformance specification.
+type Case struct {
+	Name      string
+	Ingresses []*extensions.Ingress
+	Services  []*api.Service
+	Endpoints []*api.Endpoints
+	Expected  []Expectation
+}
+
+// Expectation is the backend that must receive a request for host and path.
+type Expectation struct {
+	Host    string
+	Path    string
+	Backend string
+}
+
+// Check routes the requests of the expectations and returns the differences.
+func (c Case) Check(router Router) []string {
+	failures := []string{}
+	for _, e := range c.Expected {
+		if backend := router.Route(e.Host, e.Path); backend != e.Backend {
+			failures = append(failures, fmt.Sprintf("%v%v: expected backend %q but got %q", e.Host, e.Path, e.Backend, backend))
+		}
+	}
+	return failures
+}
+
+// Cases returns the conformance specification.
+func Cases() []Case {
+	return caseList{
+		{
+			Name: "single host",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, nil,
+					NewRule("foo.bar.com", NewPath("/foo", "foo-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+				{"foo.bar.com", "/bar", DefaultBackend},
+				{"other.com", "/foo", DefaultBackend},
+			},
+		},
+		{
+			Name: "multiple hosts",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, nil,
+					NewRule("foo.bar.com", NewPath("/foo", "foo-svc")),
+					NewRule("bar.baz.com", NewPath("/foo", "bar-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+				{"bar.baz.com", "/foo", barSvc},
+			},
+		},
+		{
+			Name: "root and specific paths",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, nil,
+					NewRule("foo.bar.com", NewPath("/", "root-svc"), NewPath("/foo", "foo-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/", rootSvc},
+				{"foo.bar.com", "/foo", fooSvc},
+			},
+		},
+		{
+			Name: "rule without host",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, nil,
+					NewRule("", NewPath("/foo", "foo-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+				{"other.com", "/foo", fooSvc},
+			},
+		},
+		{
+			Name: "default backend",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, NewBackend("default-svc"),
+					NewRule("foo.bar.com", NewPath("/foo", "foo-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+				{"foo.bar.com", "/bar", defaultSvc},
+				{"other.com", "/", defaultSvc},
+			},
+		},
+		{
+			Name: "missing service",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, nil,
+					NewRule("foo.bar.com", NewPath("/foo", "foo-svc"), NewPath("/missing", "missing-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+				{"foo.bar.com", "/missing", DefaultBackend},
+			},
+		},
+		{
+			Name: "multiple namespaces",
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "a", 0, nil,
+					NewRule("foo.bar.com", NewPath("/foo", "foo-svc"))),
+				NewIngress(otherNamespace, "a", 1, nil,
+					NewRule("bar.baz.com", NewPath("/foo", "foo-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+				{"bar.baz.com", "/foo", teamFooSvc},
+			},
+		},
+		{
+			Name: "conflicting ingresses",
+			// the newest Ingress is returned first by the apiserver
+			Ingresses: []*extensions.Ingress{
+				NewIngress(api.NamespaceDefault, "newer", 10, nil,
+					NewRule("foo.bar.com", NewPath("/foo", "bar-svc"))),
+				NewIngress(api.NamespaceDefault, "older", 0, nil,
+					NewRule("foo.bar.com", NewPath("/foo", "foo-svc"))),
+			},
+			Expected: []Expectation{
+				{"foo.bar.com", "/foo", fooSvc},
+			},
+		},
+	}.withServices()
+}
+
+// caseList adds the services used in the cases.
+type caseList []Case
+
+func (cases caseList) withServices() []Case {
+	for i := range cases {
+		nodePort := 30000
+		for _, ns := range []string{api.NamespaceDefault, otherNamespace} {
+			for _, name := range []string{"foo-svc", "bar-svc", "root-svc", "default-svc"} {
+				nodePort++
+				cases[i].Services = append(cases[i].Services, NewService(ns, name, nodePort))
+				cases[i].Endpoints = append(cases[i].Endpoints, NewEndpoints(ns, name, "10.0.0.1"))
+			}
+		}
+	}
+	return cases
+}
+
+// NewIngress returns an Ingress created age minutes after the first Ingress
+func NewIngress(ns, name string, age int, backend *extensions.IngressBackend, rules ...extensions.IngressRule) *extensions.Ingress {
+	return &extensions.Ingress{
+		ObjectMeta: api.ObjectMeta{
+			Name:              name,
+			Namespace:         ns,
+			CreationTimestamp: unversioned.NewTime(baseTime.Add(time.Duration(age) * time.Minute)),
+		},
+		Spec: extensions.IngressSpec{
+			Backend: backend,
+			Rules:   rules,
+		},
+	}
+}
+
+// NewRule returns an Ingress rule for host with the paths
+func NewRule(host string, paths ...extensions.HTTPIngressPath) extensions.IngressRule {
+	return extensions.IngressRule{
+		Host: host,
+		IngressRuleValue: extensions.IngressRuleValue{
+			HTTP: &extensions.HTTPIngressRuleValue{
+				Paths: paths,
+			},
+		},
+	}
+}
+
+// NewPath returns an Ingress path pointing to the port 80 of the service
+func NewPath(path, svc string) extensions.HTTPIngressPath {
+	return extensions.HTTPIngressPath{
+		Path:    path,
+		Backend: *NewBackend(svc),
+	}
+}
+
+// NewBackend returns an Ingress backend pointing to the port 80 of the service
+func NewBackend(svc string) *extensions.IngressBackend {
+	return &extensions.IngressBackend{
+		ServiceName: svc,
+		ServicePort: intstr.FromInt(servicePort),
+	}
+}
+
+// NewService returns a NodePort service that exposes the port 80
+func NewService(ns, name string, nodePort int) *api.Service {
+	return &api.Service{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.ServiceSpec{
+			Type: api.ServiceTypeNodePort,
+			Ports: []api.ServicePort{
+				{
+					Protocol:   api.ProtocolTCP,
+					Port:       servicePort,
+					TargetPort: intstr.FromInt(8080),
+					NodePort:   nodePort,
+				},
+			},
+		},
+	}
+}
+
+// NewEndpoints returns the endpoints of a service
+func NewEndpoints(ns, name string, ips ...string) *api.Endpoints {
+	addresses := []api.EndpointAddress{}
+	for _, ip := range ips {
+		addresses = append(addresses, api.EndpointAddress{IP: ip})
+	}
+
+	return &api.Endpoints{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Subsets: []api.EndpointSubset{
+			{
+				Addresses: addresses,
+				Ports:     []api.EndpointPort{{Port: 8080, Protocol: api.ProtocolTCP}},
+			},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// conformance contains a test suite shared by all the Ingress controllers.
+// Every controller turns Ingress objects into routing rules (a GCE url map,
+// an nginx.conf, an haproxy.cfg) and this package checks the result against
+// a common specification:
+//
+// apiserver (fixtures) <-> controller ---> generated routing <-- expectations
+//
+// * fixtures: each Case contains Ingress, Services and Endpoints served by a
+//   fake apiserver (NewAPIServer).
+// * adapter: a small Adapter, written in the controller package test files,
+//   runs the controller against the fake apiserver and returns a Router that
+//   answers which backend receives a request using the generated config.
+// * expectations: each Case lists host/path -> backend results.
+//
+// Controllers that still handle part of the specification differently list
+// those cases in KnownFailures, so the differences are documented and a
+// fix is detected as soon as it lands.
+//
+// Only the nginx-third-party adapter runs its controller. The nginx-alpha
+// adapter renders the nginx.conf template of the controller from the listed
+// Ingresses and the gce adapter syncs the url maps with a fake cloud, so
+// they check the generated configuration but not the controller loop. The
+// haproxy-alpha controller is not part of the suite: it depends on go-sh,
+// which is not vendored.
+
+package conformance
@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// ParseNginxConf extracts the routing rules of an nginx.conf that uses a
+// server per host and prefix locations with proxy_pass to the DNS name of
+// the services. Servers without server_name are rejected by nginx and are
+// ignored.
+func ParseNginxConf(conf string) (RouteTable, error) {
+	table := RouteTable{}
+	var hosts []string
+	var location string
+
+	scanner := bufio.NewScanner(strings.NewReader(conf))
+	for scanner.Scan() {
+		fields := strings.Fields(strings.TrimSuffix(strings.TrimSpace(scanner.Text()), ";"))
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "server":
+			hosts = nil
+			location = ""
+		case "server_name":
+			hosts = fields[1:]
+		case "location":
+			if len(fields) > 1 {
+				location = fields[1]
+			}
+		case "proxy_pass":
+			if len(fields) != 2 || location == "" {
+				return nil, fmt.Errorf("invalid proxy_pass: %v", scanner.Text())
+			}
+			backend, err := BackendFromDNS(fields[1])
+			if err != nil {
+				return nil, err
+			}
+			for _, host := range hosts {
+				table = append(table, Rule{Host: host, Path: location, Prefix: true, Backend: backend})
+			}
+		}
+	}
+
+	return table, scanner.Err()
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultBackend is the backend returned by a Router when the request does
+// not match any rule and is served by the default backend of the controller
+// (usually a 404 page).
+const DefaultBackend = ""
+
+// Router returns the backend that receives a request for host and path using
+// the configuration generated by a controller. Backends take the form
+// namespace/service:port.
+type Router interface {
+	Route(host, path string) string
+}
+
+// Rule is a routing rule extracted from the configuration of a controller.
+type Rule struct {
+	// Host of the rule. Empty means any host.
+	Host string
+	// Path of the rule. Empty means any path.
+	Path string
+	// Prefix is true if Path matches all the paths that start with it.
+	Prefix bool
+	// Backend takes the form namespace/service:port
+	Backend string
+}
+
+// RouteTable is a Router for controllers whose config is a list of
+// host/path rules. Rules for the exact host are checked before the rules
+// without host. For each host an exact path wins over the longest prefix,
+// and a rule without path is used only if no other rule matches.
+type RouteTable []Rule
+
+// Route implements Router.
+func (t RouteTable) Route(host, path string) string {
+	for _, h := range []string{host, ""} {
+		if backend, ok := t.match(h, path); ok {
+			return backend
+		}
+	}
+	return DefaultBackend
+}
+
+func (t RouteTable) match(host, path string) (string, bool) {
+	var catchAll, prefix *Rule
+	for i := range t {
+		rule := &t[i]
+		if rule.Host != host {
+			continue
+		}
+
+		switch {
+		case rule.Path == "":
+			if catchAll == nil {
+				catchAll = rule
+			}
+		case !rule.Prefix:
+			if rule.Path == path {
+				return rule.Backend, true
+			}
+		case strings.HasPrefix(path, rule.Path):
+			if prefix == nil || len(rule.Path) > len(prefix.Path) {
+				prefix = rule
+			}
+		}
+	}
+
+	if prefix != nil {
+		return prefix.Backend, true
+	}
+	if catchAll != nil {
+		return catchAll.Backend, true
+	}
+	return "", false
+}
+
+// BackendFromDNS converts an upstream in the form
+// service.namespace.svc.cluster.local:port to namespace/service:port.
+func BackendFromDNS(upstream string) (string, error) {
+	upstream = strings.TrimPrefix(upstream, "http://")
+	hostPort := strings.Split(upstream, ":")
+	if len(hostPort) != 2 {
+		return "", fmt.Errorf("invalid upstream %q", upstream)
+	}
+
+	labels := strings.Split(hostPort[0], ".")
+	if len(labels) < 3 || labels[2] != "svc" {
+		return "", fmt.Errorf("invalid service name %q", hostPort[0])
+	}
+
+	return Backend(labels[1], labels[0], hostPort[1]), nil
+}
+
+// Backend returns the backend namespace/service:port
+func Backend(ns, svc string, port interface{}) string {
+	return fmt.Sprintf("%v/%v:%v", ns, svc, port)
+}
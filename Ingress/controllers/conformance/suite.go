@@ -0,0 +1,62 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"testing"
+)
+
+// Adapter runs a controller against the fake apiserver.
+type Adapter interface {
+	// Sync runs the controller using the apiserver located in url until the
+	// configuration for the current objects is generated and returns a Router
+	// that uses that configuration.
+	Sync(url string) (Router, error)
+
+	// KnownFailures returns the names of the cases the controller does not
+	// pass and the reason.
+	KnownFailures() map[string]string
+}
+
+// Run runs all the cases against the controller behind the adapter.
+// Failures in known failures are logged. A known failure that passes is an
+// error, to keep the list up to date.
+func Run(t *testing.T, adapter Adapter) {
+	known := adapter.KnownFailures()
+	for _, c := range Cases() {
+		server := NewAPIServer(c)
+		router, err := adapter.Sync(server.URL)
+		server.Close()
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", c.Name, err)
+			continue
+		}
+
+		failures := c.Check(router)
+		reason, isKnown := known[c.Name]
+		switch {
+		case isKnown && len(failures) == 0:
+			t.Errorf("%v: known failure (%v) passed. Remove it from the known failures", c.Name, reason)
+		case isKnown:
+			t.Logf("%v: known failure (%v): %v", c.Name, reason, failures)
+		default:
+			for _, failure := range failures {
+				t.Errorf("%v: %v", c.Name, failure)
+			}
+		}
+	}
+}
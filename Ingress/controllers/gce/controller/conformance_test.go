@@ -0,0 +1,164 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/contrib/Ingress/controllers/conformance"
+	"k8s.io/contrib/Ingress/controllers/gce/loadbalancers"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// conformanceAdapter syncs every Ingress against the fake cloud and routes
+// requests using the resulting url maps.
+type conformanceAdapter struct{}
+
+func (conformanceAdapter) Sync(url string) (conformance.Router, error) {
+	cm := NewFakeClusterManager(DefaultClusterUID)
+	kubeClient := client.NewOrDie(&client.Config{Host: url, GroupVersion: testapi.Default.GroupVersion()})
+	lbc, err := NewLoadBalancerController(kubeClient, cm.ClusterManager, 1*time.Second, api.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	router := &urlMapRouter{
+		backends: map[string]string{
+			cm.ClusterNamer.BeName(testDefaultBeNodePort): conformance.DefaultBackend,
+		},
+	}
+
+	svcs, err := kubeClient.Services(api.NamespaceAll).List(api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range svcs.Items {
+		svc := &svcs.Items[i]
+		lbc.svcLister.Store.Add(svc)
+		for _, p := range svc.Spec.Ports {
+			router.backends[cm.ClusterNamer.BeName(int64(p.NodePort))] = conformance.Backend(svc.Namespace, svc.Name, p.Port)
+		}
+	}
+
+	ings, err := kubeClient.Extensions().Ingress(api.NamespaceAll).List(api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range ings.Items {
+		lbc.ingLister.Store.Add(&ings.Items[i])
+	}
+	for i := range ings.Items {
+		key, err := keyFunc(&ings.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		lbc.sync(key)
+
+		l7, err := cm.l7Pool.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		um, err := cm.fakeLbs.GetUrlMap(cm.ClusterNamer.Truncate(fmt.Sprintf("%v-%v", urlMapPrefix, l7.Name)))
+		if err != nil {
+			return nil, err
+		}
+		router.urlMaps = append(router.urlMaps, um)
+	}
+
+	return router, nil
+}
+
+func (conformanceAdapter) KnownFailures() map[string]string {
+	return map[string]string{
+		"conflicting ingresses": "each Ingress gets its own loadbalancer, the first one in the list wins",
+	}
+}
+
+// urlMapRouter routes requests using the url maps of all the loadbalancers.
+// The request is sent to the first loadbalancer with a host rule for the
+// host, as if DNS pointed each host to that loadbalancer.
+type urlMapRouter struct {
+	urlMaps []*compute.UrlMap
+	// backends maps the name of backend services to namespace/service:port
+	backends map[string]string
+}
+
+func (r *urlMapRouter) Route(host, path string) string {
+	if len(r.urlMaps) == 0 {
+		return conformance.DefaultBackend
+	}
+
+	for _, h := range []string{host, loadbalancers.DefaultHost} {
+		for _, um := range r.urlMaps {
+			if pm := pathMatcherForHost(um, h); pm != nil {
+				return r.backend(routePath(pm, path))
+			}
+		}
+	}
+	return r.backend(r.urlMaps[0].DefaultService)
+}
+
+func (r *urlMapRouter) backend(selfLink string) string {
+	if svc, ok := r.backends[selfLink]; ok {
+		return svc
+	}
+	return conformance.DefaultBackend
+}
+
+func pathMatcherForHost(um *compute.UrlMap, host string) *compute.PathMatcher {
+	for _, hr := range um.HostRules {
+		for _, h := range hr.Hosts {
+			if h != host {
+				continue
+			}
+			for _, pm := range um.PathMatchers {
+				if pm.Name == hr.PathMatcher {
+					return pm
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// routePath returns the service of the longest path rule that matches. Paths
+// ending in /* match as prefix, other paths must be equal.
+func routePath(pm *compute.PathMatcher, path string) string {
+	service := pm.DefaultService
+	longest := -1
+	for _, rule := range pm.PathRules {
+		for _, p := range rule.Paths {
+			prefix := strings.TrimSuffix(p, "*")
+			matched := path == p || (prefix != p && strings.HasPrefix(path, prefix))
+			if matched && len(p) > longest {
+				service = rule.Service
+				longest = len(p)
+			}
+		}
+	}
+	return service
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformanceAdapter{})
+}
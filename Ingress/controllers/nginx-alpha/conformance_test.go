@@ -0,0 +1,64 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+
+	"k8s.io/contrib/Ingress/controllers/conformance"
+)
+
+// conformanceAdapter renders nginx.conf and parses the generated servers
+type conformanceAdapter struct{}
+
+func (conformanceAdapter) Sync(url string) (conformance.Router, error) {
+	kubeClient := client.NewOrDie(&client.Config{Host: url, GroupVersion: testapi.Default.GroupVersion()})
+	ingresses, err := kubeClient.Extensions().Ingress(api.NamespaceAll).List(api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("nginx").Parse(nginxConf)
+	if err != nil {
+		return nil, err
+	}
+	var conf bytes.Buffer
+	if err := tmpl.Execute(&conf, ingresses); err != nil {
+		return nil, err
+	}
+
+	return conformance.ParseNginxConf(conf.String())
+}
+
+func (conformanceAdapter) KnownFailures() map[string]string {
+	return map[string]string{
+		"rule without host":     "the server block has an empty server_name",
+		"default backend":       "Spec.Backend is ignored",
+		"missing service":       "services are resolved using DNS and not checked",
+		"conflicting ingresses": "the first server with the same server_name wins, in list order",
+	}
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformanceAdapter{})
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+
+	"k8s.io/contrib/Ingress/controllers/conformance"
+	"k8s.io/contrib/Ingress/controllers/nginx-third-party/nginx"
+)
+
+// conformanceAdapter builds the routing table sent to ingress.lua
+type conformanceAdapter struct{}
+
+func (conformanceAdapter) Sync(url string) (conformance.Router, error) {
+	kubeClient := client.NewOrDie(&client.Config{Host: url, GroupVersion: testapi.Default.GroupVersion()})
+	ingList, err := kubeClient.Extensions().Ingress(api.NamespaceAll).List(api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	ings := []*extensions.Ingress{}
+	for i := range ingList.Items {
+		ings = append(ings, &ingList.Items[i])
+	}
+
+	return routingTableRouter{nginx.NewRoutingTable(ings)}, nil
+}
+
+func (conformanceAdapter) KnownFailures() map[string]string {
+	return map[string]string{
+		"missing service": "services are resolved using DNS and not checked",
+	}
+}
+
+type routingTableRouter struct {
+	table *nginx.RoutingTable
+}
+
+func (r routingTableRouter) Route(host, path string) string {
+	backend := r.table.Match(host, path)
+	if backend == nil {
+		return conformance.DefaultBackend
+	}
+
+	svc, err := conformance.BackendFromDNS(backend.Host + ":" + backend.Port)
+	if err != nil {
+		return conformance.DefaultBackend
+	}
+	return svc
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformanceAdapter{})
+}
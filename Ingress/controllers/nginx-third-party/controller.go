@@ -17,9 +17,13 @@ limitations under the License.
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"reflect"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -45,21 +49,6 @@ const (
 	// configuration.
 	lbConfigName = "lbconfig"
 
-	// If you have pure tcp services or https services that need L3 routing, you
-	// must specify them by name. Note that you are responsible for:
-	// 1. Making sure there is no collision between the service ports of these services.
-	//  - You can have multiple <mysql svc name>:3306 specifications in this map, and as
-	//    long as the service ports of your mysql service don't clash, you'll get
-	//    loadbalancing for each one.
-	// 2. Exposing the service ports as node ports on a pod.
-	// 3. Adding firewall rules so these ports can ingress traffic.
-
-	// Comma separated list of tcp/https
-	// namespace/serviceName:portToExport pairings. This assumes you've opened up the right
-	// hostPorts for each service that serves ingress traffic. Te value of portToExport indicates the
-	// port to listen inside nginx, not the port of the service.
-	lbTcpServices = "tcpservices"
-
 	k8sAnnotationPrefix = "nginx-ingress.kubernetes.io"
 )
 
@@ -73,8 +62,16 @@ type loadBalancerController struct {
 	client           *client.Client
 	ingController    *framework.Controller
 	configController *framework.Controller
+	tcpController    *framework.Controller
+	udpController    *framework.Controller
+	svcController    *framework.Controller
 	ingLister        StoreToIngressLister
 	configLister     StoreToConfigMapLister
+	tcpLister        StoreToConfigMapLister
+	udpLister        StoreToConfigMapLister
+	svcLister        cache.StoreToServiceLister
+	tcpConfigMapName string
+	udpConfigMapName string
 	recorder         record.EventRecorder
 	ingQueue         *taskQueue
 	configQueue      *taskQueue
@@ -86,6 +83,9 @@ type loadBalancerController struct {
 	// allowing concurrent stoppers leads to stack traces.
 	stopLock sync.Mutex
 	shutdown bool
+	// streamWarnings contains the last warning of each port of the TCP and UDP
+	// ConfigMaps, so the same warning is only reported once
+	streamWarnings map[api.Protocol]map[string]string
 }
 
 type annotations map[string]string
@@ -95,13 +95,8 @@ func (a annotations) getNginxConfig() (string, bool) {
 	return val, ok
 }
 
-func (a annotations) getTcpServices() (string, bool) {
-	val, ok := a[fmt.Sprintf("%v/%v", k8sAnnotationPrefix, lbTcpServices)]
-	return val, ok
-}
-
 // NewLoadBalancerController creates a controller for nginx loadbalancer
-func NewLoadBalancerController(kubeClient *client.Client, resyncPeriod time.Duration, defaultSvc, customErrorSvc nginx.Service, namespace string, lbInfo *lbInfo) (*loadBalancerController, error) {
+func NewLoadBalancerController(kubeClient *client.Client, resyncPeriod time.Duration, defaultSvc, customErrorSvc nginx.Service, namespace, tcpConfigMapName, udpConfigMapName string, lbInfo *lbInfo) (*loadBalancerController, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(glog.Infof)
 	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
@@ -111,7 +106,9 @@ func NewLoadBalancerController(kubeClient *client.Client, resyncPeriod time.Dura
 		stopCh: make(chan struct{}),
 		recorder: eventBroadcaster.NewRecorder(
 			api.EventSource{Component: "nginx-lb-controller"}),
-		lbInfo: lbInfo,
+		lbInfo:           lbInfo,
+		tcpConfigMapName: tcpConfigMapName,
+		udpConfigMapName: udpConfigMapName,
 	}
 	lbc.ingQueue = NewTaskQueue(lbc.syncIngress)
 	lbc.configQueue = NewTaskQueue(lbc.syncConfig)
@@ -122,7 +119,7 @@ func NewLoadBalancerController(kubeClient *client.Client, resyncPeriod time.Dura
 	pathHandlers := framework.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			addIng := obj.(*extensions.Ingress)
-			lbc.recorder.Eventf(addIng, api.EventTypeNormal, "ADD", fmt.Sprintf("Adding ingress %s/%s", addIng.Namespace, addIng.Name))
+			lbc.recorder.Eventf(addIng, api.EventTypeNormal, "ADD", "Adding ingress %s/%s", addIng.Namespace, addIng.Name)
 			lbc.ingQueue.enqueue(obj)
 		},
 		DeleteFunc: lbc.ingQueue.enqueue,
@@ -169,9 +166,114 @@ func NewLoadBalancerController(kubeClient *client.Client, resyncPeriod time.Dura
 		},
 		&api.ReplicationController{}, resyncPeriod, configHandlers)
 
+	// TCP and UDP services are defined in ConfigMaps. Any change triggers a
+	// sync of the nginx configuration.
+	lbc.tcpLister.Store, lbc.tcpController = lbc.newStreamConfigMapInformer(tcpConfigMapName, resyncPeriod)
+	lbc.udpLister.Store, lbc.udpController = lbc.newStreamConfigMapInformer(udpConfigMapName, resyncPeriod)
+
+	// the services exposed by the TCP and UDP ConfigMaps are read from this store.
+	// They can be in any namespace, and a change in one of them triggers a sync
+	// of the nginx configuration.
+	svcHandlers := framework.ResourceEventHandlerFuncs{
+		AddFunc:    lbc.enqueueStreamService,
+		DeleteFunc: lbc.enqueueStreamService,
+		UpdateFunc: func(old, cur interface{}) {
+			if !reflect.DeepEqual(old, cur) {
+				lbc.enqueueStreamService(cur)
+			}
+		},
+	}
+	lbc.svcLister.Store, lbc.svcController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts api.ListOptions) (runtime.Object, error) {
+				return lbc.client.Services(api.NamespaceAll).List(opts)
+			},
+			WatchFunc: func(opts api.ListOptions) (watch.Interface, error) {
+				return lbc.client.Services(api.NamespaceAll).Watch(opts)
+			},
+		},
+		&api.Service{}, resyncPeriod, svcHandlers)
+
 	return &lbc, nil
 }
 
+// newStreamConfigMapInformer returns an informer that watches the ConfigMaps in the
+// namespace of the ConfigMap name (namespace/name) and enqueues a sync of the nginx
+// configuration if the ConfigMap changes.
+func (lbc *loadBalancerController) newStreamConfigMapInformer(name string, resyncPeriod time.Duration) (cache.Store, *framework.Controller) {
+	ns, _, err := parseNsName(name)
+	if err != nil {
+		// nothing to watch
+		ns = api.NamespaceDefault
+	}
+
+	enqueue := func(obj interface{}) {
+		if key, err := keyFunc(obj); err == nil && key == name {
+			lbc.configQueue.queue.Add(lbc.rcKey())
+		}
+	}
+
+	return framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts api.ListOptions) (runtime.Object, error) {
+				return lbc.client.Extensions().ConfigMaps(ns).List(opts)
+			},
+			WatchFunc: func(opts api.ListOptions) (watch.Interface, error) {
+				return lbc.client.Extensions().ConfigMaps(ns).Watch(opts)
+			},
+		},
+		&extensions.ConfigMap{}, resyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    enqueue,
+			DeleteFunc: enqueue,
+			UpdateFunc: func(old, cur interface{}) {
+				enqueue(cur)
+			},
+		})
+}
+
+// enqueueStreamService enqueues a sync of the nginx configuration if the service
+// is exposed in the TCP or UDP ConfigMap
+func (lbc *loadBalancerController) enqueueStreamService(obj interface{}) {
+	key, err := keyFunc(obj)
+	if err != nil {
+		glog.Warningf("couldn't get key for service %+v: %v", obj, err)
+		return
+	}
+	if lbc.isStreamService(key, lbc.tcpConfigMapName, lbc.tcpLister) || lbc.isStreamService(key, lbc.udpConfigMapName, lbc.udpLister) {
+		lbc.configQueue.queue.Add(lbc.rcKey())
+	}
+}
+
+// isStreamService returns true if the service (namespace/name) is exposed in the
+// ConfigMap name
+func (lbc *loadBalancerController) isStreamService(svc, name string, lister StoreToConfigMapLister) bool {
+	if name == "" {
+		return false
+	}
+	obj, exists, err := lister.Store.GetByKey(name)
+	if err != nil || !exists {
+		return false
+	}
+	for _, value := range obj.(*extensions.ConfigMap).Data {
+		if nsName, _, err := splitServicePort(value); err == nil && nsName == svc {
+			return true
+		}
+	}
+	return false
+}
+
+// streamStoresSynced returns true once the stores of the TCP and UDP ConfigMaps and
+// of the services were populated
+func (lbc *loadBalancerController) streamStoresSynced() bool {
+	return lbc.tcpController.HasSynced() && lbc.udpController.HasSynced() && lbc.svcController.HasSynced()
+}
+
+// rcKey returns the key (namespace/name) of the nginx replication controller
+func (lbc *loadBalancerController) rcKey() string {
+	return fmt.Sprintf("%v/%v", lbc.lbInfo.RCNamespace, lbc.lbInfo.RCName)
+}
+
 func ingressListFunc(c *client.Client, ns string) func(api.ListOptions) (runtime.Object, error) {
 	return func(opts api.ListOptions) (runtime.Object, error) {
 		return c.Extensions().Ingress(ns).List(opts)
@@ -201,16 +303,25 @@ func (lbc *loadBalancerController) syncIngress(key string) {
 
 	// this means some Ingress rule changed. There is no need to reload nginx but
 	// we need to update the rules to use invoking "POST /update-ingress" with the
-	// list of Ingress rules
-	ingList := lbc.ingLister.Store.List()
-	if err := lbc.ngx.SyncIngress(ingList); err != nil {
+	// routing table built from the list of Ingress rules
+	ings := []*extensions.Ingress{}
+	for _, obj := range lbc.ingLister.Store.List() {
+		ings = append(ings, obj.(*extensions.Ingress))
+	}
+
+	table := nginx.NewRoutingTable(ings)
+	for _, conflict := range table.Conflicts {
+		glog.Warningf("%v", conflict)
+	}
+
+	if err := lbc.ngx.SyncIngress(table); err != nil {
 		lbc.ingQueue.requeue(key, err)
 		return
 	}
 
 	ing := *obj.(*extensions.Ingress)
 	if err := lbc.updateIngressStatus(ing); err != nil {
-		lbc.recorder.Eventf(&ing, api.EventTypeWarning, "Status", err.Error())
+		lbc.recorder.Eventf(&ing, api.EventTypeWarning, "Status", "%v", err)
 		lbc.ingQueue.requeue(key, err)
 	}
 	return
@@ -219,7 +330,7 @@ func (lbc *loadBalancerController) syncIngress(key string) {
 // syncConfig manages changes in nginx configuration.
 func (lbc *loadBalancerController) syncConfig(key string) {
 	// we only need to sync the nginx rc
-	if key != fmt.Sprintf("%v/%v", lbc.lbInfo.RCNamespace, lbc.lbInfo.RCName) {
+	if key != lbc.rcKey() {
 		return
 	}
 
@@ -238,21 +349,142 @@ func (lbc *loadBalancerController) syncConfig(key string) {
 
 	rc := *obj.(*api.ReplicationController)
 	ngxCfgAnn, _ := annotations(rc.Annotations).getNginxConfig()
-	tcpSvcAnn, _ := annotations(rc.Annotations).getTcpServices()
 
 	ngxConfig, err := lbc.ngx.ReadConfig(ngxCfgAnn)
 	if err != nil {
 		glog.Warningf("%v", err)
 	}
 
-	// TODO: tcp services can change (new item in the annotation list)
-	// TODO: skip get everytime
-	tcpServices := getTcpServices(lbc.client, tcpSvcAnn)
-	lbc.ngx.Reload(ngxConfig, tcpServices)
+	tcpServices := lbc.getStreamServices(lbc.tcpConfigMapName, lbc.tcpLister, api.ProtocolTCP)
+	udpServices := lbc.getStreamServices(lbc.udpConfigMapName, lbc.udpLister, api.ProtocolUDP)
+	if err := lbc.ngx.Reload(ngxConfig, tcpServices, udpServices); err != nil {
+		glog.Errorf("%v", err)
+	}
 
 	return
 }
 
+// getStreamServices returns the services defined in the ConfigMap name (namespace/name).
+// Each entry maps the port nginx listens to a service in the form namespace/name:port.
+// Invalid entries, port collisions and services that do not exist are reported
+// and skipped. The next sync (or the periodic resync) checks them again.
+func (lbc *loadBalancerController) getStreamServices(name string, lister StoreToConfigMapLister, proto api.Protocol) []nginx.Service {
+	svcs := []nginx.Service{}
+	if name == "" {
+		return svcs
+	}
+
+	obj, exists, err := lister.Store.GetByKey(name)
+	if err != nil {
+		glog.Warningf("error getting %v services from ConfigMap %v: %v", proto, name, err)
+		return svcs
+	}
+	if !exists {
+		glog.Warningf("%v services ConfigMap %v not found", proto, name)
+		return svcs
+	}
+
+	cm := obj.(*extensions.ConfigMap)
+
+	// sort the ports to always generate the same configuration
+	ports := []string{}
+	for port := range cm.Data {
+		ports = append(ports, port)
+	}
+	sort.Strings(ports)
+
+	usedPorts := map[int]string{}
+	if proto == api.ProtocolTCP {
+		for _, port := range reservedPorts() {
+			usedPorts[port] = "nginx"
+		}
+	}
+
+	// warnings are reported as events only if they changed since the last sync,
+	// as the ConfigMap is checked again in every sync
+	if lbc.streamWarnings == nil {
+		lbc.streamWarnings = map[api.Protocol]map[string]string{}
+	}
+	lastWarnings := lbc.streamWarnings[proto]
+	warnings := map[string]string{}
+	warn := func(port, reason, message string) {
+		glog.Warningf("%v in ConfigMap %v", message, name)
+		warnings[port] = reason + ": " + message
+		if lastWarnings[port] != warnings[port] {
+			lbc.recorder.Eventf(cm, api.EventTypeWarning, reason, "%v", message)
+		}
+	}
+	for _, port := range ports {
+		svc, err := lbc.getStreamService(port, cm.Data[port], proto)
+		if err != nil {
+			warn(port, "InvalidService", fmt.Sprintf("%v service %v: %v", proto, port, err))
+			continue
+		}
+
+		exposedPort, _ := strconv.Atoi(svc.ExposedPort)
+		if owner, ok := usedPorts[exposedPort]; ok {
+			warn(port, "PortCollision", fmt.Sprintf("%v port %v is already used by %v", proto, exposedPort, owner))
+			continue
+		}
+		usedPorts[exposedPort] = cm.Data[port]
+
+		svcs = append(svcs, svc)
+	}
+	lbc.streamWarnings[proto] = warnings
+
+	return svcs
+}
+
+// getStreamService returns the service to use in nginx from an entry of the services
+// ConfigMap. The service port can be the port number or the name of the port.
+func (lbc *loadBalancerController) getStreamService(port, value string, proto api.Protocol) (nginx.Service, error) {
+	exposedPort, err := strconv.Atoi(port)
+	if err != nil || exposedPort < 1 || exposedPort > 65535 {
+		return nginx.Service{}, fmt.Errorf("invalid port %q", port)
+	}
+
+	nsName, svcPort, err := splitServicePort(value)
+	if err != nil {
+		return nginx.Service{}, err
+	}
+
+	ns, svcName, err := parseNsName(nsName)
+	if err != nil {
+		return nginx.Service{}, err
+	}
+
+	obj, exists, err := lbc.svcLister.Store.GetByKey(nsName)
+	if err != nil {
+		return nginx.Service{}, fmt.Errorf("error getting service %v: %v", nsName, err)
+	}
+	if !exists {
+		return nginx.Service{}, fmt.Errorf("service %v not found", nsName)
+	}
+	svc := obj.(*api.Service)
+
+	for _, p := range svc.Spec.Ports {
+		if p.Protocol != proto {
+			continue
+		}
+		if strconv.Itoa(p.Port) == svcPort || p.Name == svcPort {
+			return nginx.Service{
+				ServiceName: svcName,
+				ServicePort: strconv.Itoa(p.Port),
+				Namespace:   ns,
+				ExposedPort: port,
+			}, nil
+		}
+	}
+
+	return nginx.Service{}, fmt.Errorf("service %v does not contain the %v port %v", nsName, proto, svcPort)
+}
+
+// reservedPorts returns the TCP ports used by nginx and the controller that
+// cannot be used to expose TCP services.
+func reservedPorts() []int {
+	return []int{80, 443, 8080, *healthzPort}
+}
+
 // updateIngressStatus updates the IP and annotations of a loadbalancer.
 // The annotations are parsed by kubectl describe.
 func (lbc *loadBalancerController) updateIngressStatus(ing extensions.Ingress) error {
@@ -278,6 +510,12 @@ func (lbc *loadBalancerController) updateIngressStatus(ing extensions.Ingress) e
 
 func (lbc *loadBalancerController) registerHandlers() {
 	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if state := lbc.ngx.ProcessStatus().State; state != nginx.ProcessRunning {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf("nginx master process is %v", state)))
+			return
+		}
+
 		if err := lbc.ngx.IsHealthy(); err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte("nginx error"))
@@ -288,11 +526,36 @@ func (lbc *loadBalancerController) registerHandlers() {
 		w.Write([]byte("ok"))
 	})
 
+	http.HandleFunc("/reload-stats", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, lbc.ngx.ReloadStats())
+	})
+
+	http.HandleFunc("/process-status", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, lbc.ngx.ProcessStatus())
+	})
+
+	http.HandleFunc("/routing-table", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, lbc.ngx.RoutingTable())
+	})
+
 	http.HandleFunc("/stop", func(w http.ResponseWriter, r *http.Request) {
 		lbc.Stop()
 	})
 
-	glog.Fatalf(fmt.Sprintf("%v", http.ListenAndServe(fmt.Sprintf(":%v", *healthzPort), nil)))
+	glog.Fatalf("%v", http.ListenAndServe(fmt.Sprintf(":%v", *healthzPort), nil))
+}
+
+// writeJSON writes the json representation of obj in the response
+func writeJSON(w http.ResponseWriter, obj interface{}) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		w.WriteHeader(500)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
 }
 
 // Stop stops the loadbalancer controller.
@@ -314,14 +577,29 @@ func (lbc *loadBalancerController) Stop() {
 // Run starts the loadbalancer controller.
 func (lbc *loadBalancerController) Run() {
 	glog.Infof("Starting nginx loadbalancer controller")
-	go lbc.ngx.Start()
+	go func() {
+		if err := lbc.ngx.Start(*restartNginx); err != nil {
+			glog.Errorf("nginx master process terminated: %v", err)
+			lbc.Stop()
+			glog.Flush()
+			os.Exit(1)
+		}
+	}()
 	go lbc.registerHandlers()
 
 	go lbc.configController.Run(lbc.stopCh)
+	go lbc.tcpController.Run(lbc.stopCh)
+	go lbc.udpController.Run(lbc.stopCh)
+	go lbc.svcController.Run(lbc.stopCh)
 	go lbc.configQueue.run(time.Second, lbc.stopCh)
 
+	// the TCP and UDP services of the initial configuration are read from the stores
+	for !lbc.streamStoresSynced() {
+		time.Sleep(100 * time.Millisecond)
+	}
+
 	// Initial nginx configuration.
-	lbc.syncConfig(lbc.lbInfo.RCName)
+	lbc.syncConfig(lbc.rcKey())
 
 	time.Sleep(5 * time.Second)
 
@@ -0,0 +1,253 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/record"
+
+	"k8s.io/contrib/Ingress/controllers/nginx-third-party/nginx"
+)
+
+func TestParseNsName(t *testing.T) {
+	cases := []struct {
+		input string
+		ns    string
+		name  string
+		valid bool
+	}{
+		{"default/tcp-services", "default", "tcp-services", true},
+		{"kube-system/udp", "kube-system", "udp", true},
+		{"tcp-services", "", "", false},
+		{"/tcp-services", "", "", false},
+		{"default/", "", "", false},
+		{"default/tcp/services", "", "", false},
+		{"", "", "", false},
+	}
+	for i, test := range cases {
+		ns, name, err := parseNsName(test.input)
+		if (err == nil) != test.valid || ns != test.ns || name != test.name {
+			t.Errorf("case %d: expected %q %q (valid %v), got %q %q (%v)", i, test.ns, test.name, test.valid, ns, name, err)
+		}
+	}
+}
+
+func TestSplitServicePort(t *testing.T) {
+	cases := []struct {
+		input  string
+		nsName string
+		port   string
+		valid  bool
+	}{
+		{"default/web:80", "default/web", "80", true},
+		{"default/web:http", "default/web", "http", true},
+		{"default/web", "", "", false},
+		{"default/web:", "", "", false},
+		{"default/web:80:81", "", "", false},
+	}
+	for i, test := range cases {
+		nsName, port, err := splitServicePort(test.input)
+		if (err == nil) != test.valid || nsName != test.nsName || port != test.port {
+			t.Errorf("case %d: expected %q %q (valid %v), got %q %q (%v)", i, test.nsName, test.port, test.valid, nsName, port, err)
+		}
+	}
+}
+
+func newService(name string, ports ...api.ServicePort) *api.Service {
+	return &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec:       api.ServiceSpec{Ports: ports},
+	}
+}
+
+// newStreamController returns a controller with the services in its store and the
+// ConfigMap default/streams with data in its TCP and UDP stores.
+func newStreamController(data map[string]string, services ...*api.Service) (*loadBalancerController, *record.FakeRecorder) {
+	recorder := &record.FakeRecorder{}
+	lbc := &loadBalancerController{recorder: recorder}
+	lbc.svcLister.Store = cache.NewStore(keyFunc)
+	for _, svc := range services {
+		lbc.svcLister.Store.Add(svc)
+	}
+	lbc.tcpLister.Store = cache.NewStore(keyFunc)
+	lbc.tcpLister.Store.Add(&extensions.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "streams", Namespace: api.NamespaceDefault},
+		Data:       data,
+	})
+	return lbc, recorder
+}
+
+func TestGetStreamServices(t *testing.T) {
+	services := []*api.Service{
+		newService("web", api.ServicePort{Name: "http", Port: 80, Protocol: api.ProtocolTCP}),
+		newService("db", api.ServicePort{Port: 5432, Protocol: api.ProtocolTCP}),
+		newService("dns",
+			api.ServicePort{Name: "dns", Port: 53, Protocol: api.ProtocolUDP},
+			api.ServicePort{Name: "dns-tcp", Port: 53, Protocol: api.ProtocolTCP}),
+	}
+	cases := []struct {
+		data     map[string]string
+		proto    api.Protocol
+		expected []nginx.Service
+		events   []string
+	}{
+		{
+			data: map[string]string{
+				"9000": "default/web:80",
+				"9001": "default/web:http",
+				"5432": "default/db:5432",
+				"5353": "default/dns:dns-tcp",
+			},
+			proto: api.ProtocolTCP,
+			expected: []nginx.Service{
+				{ServiceName: "dns", ServicePort: "53", Namespace: "default", ExposedPort: "5353"},
+				{ServiceName: "db", ServicePort: "5432", Namespace: "default", ExposedPort: "5432"},
+				{ServiceName: "web", ServicePort: "80", Namespace: "default", ExposedPort: "9000"},
+				{ServiceName: "web", ServicePort: "80", Namespace: "default", ExposedPort: "9001"},
+			},
+		},
+		{
+			data: map[string]string{
+				"abc":   "default/web:80",
+				"70000": "default/web:80",
+				"9000":  "default/web",
+				"9001":  "web:80",
+				"9002":  "default/missing:80",
+				"9003":  "default/web:8080",
+				"9004":  "default/dns:dns",
+			},
+			proto:    api.ProtocolTCP,
+			expected: []nginx.Service{},
+			events:   []string{"InvalidService", "InvalidService", "InvalidService", "InvalidService", "InvalidService", "InvalidService", "InvalidService"},
+		},
+		// the ports of nginx can't expose TCP services
+		{
+			data: map[string]string{
+				"80":  "default/web:80",
+				"443": "default/db:5432",
+			},
+			proto:    api.ProtocolTCP,
+			expected: []nginx.Service{},
+			events:   []string{"PortCollision", "PortCollision"},
+		},
+		// but they can expose UDP services
+		{
+			data: map[string]string{
+				"53": "default/dns:dns",
+				"80": "default/dns:53",
+			},
+			proto: api.ProtocolUDP,
+			expected: []nginx.Service{
+				{ServiceName: "dns", ServicePort: "53", Namespace: "default", ExposedPort: "53"},
+				{ServiceName: "dns", ServicePort: "53", Namespace: "default", ExposedPort: "80"},
+			},
+		},
+		// the first entry of a port is used
+		{
+			data: map[string]string{
+				"053": "default/dns:dns",
+				"53":  "default/dns:53",
+			},
+			proto: api.ProtocolUDP,
+			expected: []nginx.Service{
+				{ServiceName: "dns", ServicePort: "53", Namespace: "default", ExposedPort: "053"},
+			},
+			events: []string{"PortCollision"},
+		},
+	}
+	for i, test := range cases {
+		lbc, recorder := newStreamController(test.data, services...)
+		svcs := lbc.getStreamServices("default/streams", lbc.tcpLister, test.proto)
+		if !reflect.DeepEqual(svcs, test.expected) {
+			t.Errorf("case %d: expected %+v, got %+v", i, test.expected, svcs)
+		}
+		reasons := []string{}
+		for _, event := range recorder.Events {
+			reasons = append(reasons, strings.Split(event, " ")[1])
+		}
+		if len(reasons) != len(test.events) || (len(reasons) != 0 && !reflect.DeepEqual(reasons, test.events)) {
+			t.Errorf("case %d: expected events %v, got %v", i, test.events, recorder.Events)
+		}
+	}
+}
+
+func TestGetStreamServicesWithoutConfigMap(t *testing.T) {
+	lbc, recorder := newStreamController(nil)
+	for _, name := range []string{"", "default/missing"} {
+		if svcs := lbc.getStreamServices(name, lbc.tcpLister, api.ProtocolTCP); len(svcs) != 0 {
+			t.Errorf("expected no services from %q, got %+v", name, svcs)
+		}
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("unexpected events %v", recorder.Events)
+	}
+}
+
+func TestGetStreamServicesReportsWarningsOnce(t *testing.T) {
+	lbc, recorder := newStreamController(map[string]string{"9000": "default/web:80"})
+	// the service doesn't exist in consecutive syncs
+	for i := 0; i < 2; i++ {
+		if svcs := lbc.getStreamServices("default/streams", lbc.tcpLister, api.ProtocolTCP); len(svcs) != 0 {
+			t.Errorf("expected no services, got %+v", svcs)
+		}
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("expected 1 event, got %v", recorder.Events)
+	}
+
+	svc := newService("web", api.ServicePort{Port: 80, Protocol: api.ProtocolTCP})
+	lbc.svcLister.Store.Add(svc)
+	if svcs := lbc.getStreamServices("default/streams", lbc.tcpLister, api.ProtocolTCP); len(svcs) != 1 {
+		t.Errorf("expected 1 service, got %+v", svcs)
+	}
+
+	// the warning is reported again when the service is removed
+	lbc.svcLister.Store.Delete(svc)
+	lbc.getStreamServices("default/streams", lbc.tcpLister, api.ProtocolTCP)
+	if len(recorder.Events) != 2 {
+		t.Errorf("expected 2 events, got %v", recorder.Events)
+	}
+}
+
+func TestEnqueueStreamService(t *testing.T) {
+	lbc, _ := newStreamController(map[string]string{"9000": "other/web:80"})
+	lbc.tcpConfigMapName = "default/streams"
+	lbc.lbInfo = &lbInfo{RCNamespace: "default", RCName: "nginx"}
+	lbc.configQueue = NewTaskQueue(func(string) {})
+
+	// a service that isn't in the ConfigMap is ignored
+	lbc.enqueueStreamService(newService("web"))
+	if n := lbc.configQueue.queue.Len(); n != 0 {
+		t.Errorf("expected no sync, got %d", n)
+	}
+
+	svc := newService("web")
+	svc.Namespace = "other"
+	lbc.enqueueStreamService(svc)
+	if n := lbc.configQueue.queue.Len(); n != 1 {
+		t.Errorf("expected a sync, got %d", n)
+	}
+	if key, _ := lbc.configQueue.queue.Get(); key != "default/nginx" {
+		t.Errorf("expected a sync of default/nginx, got %v", key)
+	}
+}
@@ -9,9 +9,8 @@ local os = require "os"
 local encode = cjson.encode
 local decode = cjson.decode
 
-local table_concat = table.concat
-
-local trie_get = trie.get
+local find = string.find
+local sub = string.sub
 local match = string.match
 local gsub = string.gsub
 local lower = string.lower
@@ -20,8 +19,6 @@ local lower = string.lower
 -- we "cache" the config local to each worker
 local ingressConfig = nil
 
-local cluster_domain = "cluster.local"
-
 local def_backend = nil
 
 local custom_error = nil
@@ -47,6 +44,67 @@ function worker_cache_config(ngx)
     end
 end
 
+-- find_server returns the server for a host. The lookup order is the exact host,
+-- the wildcard host (one label) and the server for rules without host.
+local function find_server(config, host)
+    local servers = config.servers or {}
+    local server = servers[host]
+    if server then
+        return server
+    end
+
+    local dot = find(host, ".", 1, true)
+    if dot then
+        server = servers["*" .. sub(host, dot)]
+        if server then
+            return server
+        end
+    end
+
+    return servers[""]
+end
+
+local function location_matches(location, uri)
+    local path = location.path
+    if location.type == "exact" then
+        return uri == path
+    end
+
+    if location.type == "regex" then
+        local from = ngx.re.find(uri, path, "jo")
+        return from ~= nil
+    end
+
+    -- prefix match using complete path segments
+    if path == "/" or uri == path then
+        return true
+    end
+    if sub(path, -1) ~= "/" then
+        path = path .. "/"
+    end
+    return sub(uri, 1, #path) == path
+end
+
+-- find_backend walks the locations of the server (already sorted by the
+-- controller) and returns the first match. If there is no match the default
+-- backend of the server or the Ingress default backend is used.
+local function find_backend(config, host, uri)
+    local server = find_server(config, host)
+    if server then
+        for _, location in ipairs(server.locations or {}) do
+            if location_matches(location, uri) then
+                return location.backend
+            end
+        end
+
+        if server.defaultBackend then
+            return server.defaultBackend
+        end
+    end
+
+    return config.defaultBackend
+end
+
 function _M.content(ngx)
     local host = ngx.var.host
 
@@ -64,20 +122,7 @@ function _M.content(ngx)
         return ngx.exit(503)
     end
 
-    -- this assumes we only allow exact host matches
-    local paths = config[host]
-    if not paths then
-        ngx.log(ngx.ERR, "No server for host "..host.." returning 404")
-        if custom_error then
-            openCustomErrorURL(404, custom_error)
-            return
-        else 
-            openURL(404, def_backend)
-            return
-        end
-    end
-
-    local backend = trie_get(paths, ngx.var.uri)
+    local backend = find_backend(config, host, ngx.var.uri)
 
     if not backend then
         ngx.log(ngx.ERR, "No server for host "..host.." and path "..ngx.var.uri.." returning 404")
@@ -144,7 +189,7 @@ function _M.init(ngx, options)
 
 end
 
--- dump config. This is the raw config (including trie) for now
+-- dump config. This is the routing table received from the controller
 function _M.config(ngx)
     ngx.header.content_type = "application/json"
     local config = {
@@ -154,6 +199,8 @@ function _M.config(ngx)
     ngx.print(val)
 end
 
+-- update_ingress receives the routing table built by the controller from
+-- the Ingress rules. The table is used as is.
 function _M.update_ingress(ngx)
     ngx.header.content_type = "application/json"
 
@@ -167,44 +214,11 @@ function _M.update_ingress(ngx)
 
     ngx.req.read_body()
     local data = ngx.req.get_body_data()
-    local val = decode(data)
+    local config = decode(data)
 
-    if not val then
+    if not config then
         ngx.log(ngx.ERR, "failed to decode body")
-        return
-    end
-
-    config = {}
-
-    for _, ingress in ipairs(val) do
-        local namespace = ingress.metadata.namespace
-
-        local spec = ingress.spec
-        -- we do not allow default ingress backends right now.
-        for _, rule in ipairs(spec.rules) do
-            local host = rule.host
-            local paths = config[host]
-            if not paths then
-                paths = trie.new()
-                config[host] = paths
-            end
-            rule.http = rule.http or { paths = {}}
-            for _, path in ipairs(rule.http.paths) do
-                local hostname = table_concat(
-                    {
-                        path.backend.serviceName,
-                        namespace,
-                        "svc",
-                        cluster_domain
-                    }, ".")
-                local backend = {
-                    host = hostname,
-                    port = path.backend.servicePort
-                }
-
-                paths:add(path.path, backend)
-            end
-        end
+        return ngx.exit(400)
     end
 
     local d = ngx.shared["ingress"]
@@ -18,6 +18,8 @@ package main
 
 import (
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	flag "github.com/spf13/pflag"
@@ -51,7 +53,25 @@ var (
 	watchNamespace = flags.String("watch-namespace", api.NamespaceAll,
 		`Namespace to watch for Ingress. Default is to watch all namespaces`)
 
+	tcpConfigMapName = flags.String("tcp-services-configmap", "",
+		`Name of the ConfigMap that contains the definition of the TCP services to expose.
+    Takes the form namespace/name. The key in the map indicates the external port to
+    be used. The value is the name of the service with the format namespace/serviceName
+    and the port of the service could be a number or the name of the port.`)
+
+	udpConfigMapName = flags.String("udp-services-configmap", "",
+		`Name of the ConfigMap that contains the definition of the UDP services to expose.
+    Takes the form namespace/name. Uses the same format than --tcp-services-configmap.`)
+
 	healthzPort = flags.Int("healthz-port", healthPort, "port for healthz endpoint.")
+
+	restartNginx = flags.Bool("restart-nginx", true,
+		`Restart the nginx master process (with backoff) if it terminates. If false the
+    controller exits with a non zero code when nginx terminates.`)
+
+	drainTimeout = flags.Duration("nginx-drain-timeout", 30*time.Second,
+		`Time to wait for nginx to finish serving the current requests after receiving
+    SIGTERM. After this period the nginx master process is killed.`)
 )
 
 func main() {
@@ -61,6 +81,15 @@ func main() {
 		glog.Fatalf("Please specify --default-backend")
 	}
 
+	for _, name := range []string{*tcpConfigMapName, *udpConfigMapName} {
+		if name == "" {
+			continue
+		}
+		if _, _, err := parseNsName(name); err != nil {
+			glog.Fatalf("%v", err)
+		}
+	}
+
 	kubeClient, err := unversioned.NewInCluster()
 	if err != nil {
 		glog.Fatalf("failed to create client: %v", err)
@@ -71,11 +100,13 @@ func main() {
 	defError := getService(kubeClient, *customErrorSvc)
 
 	// Start loadbalancer controller
-	lbc, err := NewLoadBalancerController(kubeClient, *resyncPeriod, defSvc, defError, *watchNamespace, lbInfo)
+	lbc, err := NewLoadBalancerController(kubeClient, *resyncPeriod, defSvc, defError, *watchNamespace, *tcpConfigMapName, *udpConfigMapName, lbInfo)
 	if err != nil {
 		glog.Fatalf("%v", err)
 	}
 
+	go handleSigterm(lbc)
+
 	lbc.Run()
 
 	for {
@@ -84,6 +115,26 @@ func main() {
 	}
 }
 
+// handleSigterm stops the controller and sends a graceful quit to nginx
+// after receiving SIGTERM, giving nginx time to drain current connections.
+func handleSigterm(lbc *loadBalancerController) {
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, syscall.SIGTERM)
+	<-signalChan
+	glog.Infof("Received SIGTERM, shutting down")
+
+	exitCode := 0
+	lbc.Stop()
+	if err := lbc.ngx.Quit(*drainTimeout); err != nil {
+		glog.Errorf("Error during shutdown: %v", err)
+		exitCode = 1
+	}
+
+	glog.Infof("Exiting with %v", exitCode)
+	glog.Flush()
+	os.Exit(exitCode)
+}
+
 // lbInfo contains runtime information about the pod and replication controller
 type lbInfo struct {
 	RCNamespace  string
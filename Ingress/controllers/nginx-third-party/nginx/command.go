@@ -17,31 +17,142 @@ limitations under the License.
 package nginx
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"time"
 
 	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/util"
 )
 
 const (
 	nginxEvent = "NGINX"
+
+	// initial and maximum wait before a new nginx master process is started
+	// after the previous one terminated
+	initialRestartBackoff = 1 * time.Second
+	maxRestartBackoff     = 1 * time.Minute
 )
 
-// Start starts a nginx (master process) and waits. If the process ends
-// we need to kill the controller process and return the reason.
-func (ngx *NginxManager) Start() {
-	cmd := exec.Command("nginx")
+// Start starts a nginx (master process) and supervises it. If the process ends
+// and restart is true a new master process is started after a backoff period.
+// If restart is false the reason is returned so the controller can terminate.
+// A process that ends after Quit is invoked is not considered a failure.
+func (ngx *NginxManager) Start(restart bool) error {
+	backoff := util.NewBackOff(initialRestartBackoff, maxRestartBackoff)
+	for {
+		err := ngx.runMaster()
+		if ngx.isQuitting() {
+			ngx.process.exited(ProcessStopped, err)
+			return nil
+		}
+
+		if err == nil {
+			err = fmt.Errorf("nginx master process exited without error")
+		}
+
+		if !restart {
+			ngx.process.exited(ProcessExited, err)
+			return err
+		}
+
+		ngx.process.exited(ProcessRestarting, err)
+		backoff.Next(nginxEvent, time.Now())
+		delay := backoff.Get(nginxEvent)
+		glog.Errorf("nginx master process terminated (%v). Restarting in %v", err, delay)
+
+		select {
+		case <-ngx.quitCh:
+			ngx.process.setState(ProcessStopped)
+			return nil
+		case <-time.After(delay):
+		}
+
+		ngx.process.restarted()
+	}
+}
+
+// runMaster starts a nginx master process and waits until it terminates
+func (ngx *NginxManager) runMaster() error {
+	ngx.process.setState(ProcessStarting)
+
+	cmd := ngx.command()
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+
+	// the process is started under the lock so Quit either sees it or prevents
+	// it from being started
+	ngx.processLock.Lock()
+	if ngx.quitting {
+		ngx.processLock.Unlock()
+		return nil
+	}
 	if err := cmd.Start(); err != nil {
-		glog.Errorf("nginx error: %v", err)
+		ngx.processLock.Unlock()
+		return err
 	}
+	done := make(chan struct{})
+	ngx.master = cmd
+	ngx.masterDone = done
+	ngx.processLock.Unlock()
 
-	if err := cmd.Wait(); err != nil {
-		glog.Errorf("nginx error: %v", err)
+	ngx.process.started(cmd.Process.Pid)
+	glog.Infof("nginx master process started (pid %v)", cmd.Process.Pid)
+
+	err := cmd.Wait()
+
+	ngx.processLock.Lock()
+	ngx.master = nil
+	ngx.masterDone = nil
+	ngx.processLock.Unlock()
+	close(done)
+
+	return err
+}
+
+// Quit sends the quit signal to nginx (graceful shutdown). The master process
+// stops accepting new connections and waits until the current requests are
+// serviced. If the process is still running after the timeout it is killed.
+func (ngx *NginxManager) Quit(timeout time.Duration) error {
+	ngx.processLock.Lock()
+	if !ngx.quitting {
+		ngx.quitting = true
+		close(ngx.quitCh)
+	}
+	master := ngx.master
+	done := ngx.masterDone
+	ngx.processLock.Unlock()
+
+	if master == nil {
+		return nil
+	}
+
+	ngx.process.setState(ProcessStopping)
+	glog.Infof("Sending quit signal to nginx. Waiting %v to drain connections", timeout)
+	if err := ngx.signal("quit"); err != nil {
+		glog.Warningf("unexpected error sending quit signal to nginx: %v", err)
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		master.Process.Kill()
+		<-done
+		return fmt.Errorf("nginx master process did not finish in %v and was killed", timeout)
 	}
 }
 
+func (ngx *NginxManager) isQuitting() bool {
+	ngx.processLock.Lock()
+	defer ngx.processLock.Unlock()
+	return ngx.quitting
+}
+
 // Reload the master process receives the signal to reload configuration, it checks
 // the syntax validity of the new configuration file and tries to apply the
 // configuration provided in it. If this is a success, the master process starts
@@ -51,28 +162,97 @@ func (ngx *NginxManager) Start() {
 // shut down, stop accepting new connections and continue to service current requests
 // until all such requests are serviced. After that, the old worker processes exit.
 // http://nginx.org/en/docs/beginners_guide.html#control
-func (ngx *NginxManager) Reload(cfg *nginxConfiguration, servicesL4 []Service) {
+//
+// The new configuration is rendered in memory and compared with the configuration
+// of the last successful reload. If the checksum is the same there is no need to
+// reload nginx. If not, the content is written to a temporary file that is validated
+// with "nginx -t" before it replaces the configuration file. A configuration whose
+// reload failed is applied again in the next call.
+func (ngx *NginxManager) Reload(cfg *nginxConfiguration, tcpServices, udpServices []Service) error {
 	ngx.reloadLock.Lock()
 	defer ngx.reloadLock.Unlock()
 
-	if err := ngx.writeCfg(cfg, servicesL4); err != nil {
-		glog.Errorf("Failed to write new nginx configuration. Avoiding reload: %v", err)
-		return
+	content, err := ngx.generateCfg(cfg, tcpServices, udpServices)
+	if err != nil {
+		ngx.stats.reloadFailed(err)
+		return fmt.Errorf("failed to generate new nginx configuration: %v", err)
+	}
+
+	newSum := checksum(content)
+	if ngx.stats.get().LastChecksum == newSum {
+		glog.V(2).Infof("nginx configuration did not change (%v). Avoiding reload", newSum)
+		ngx.stats.reloadSkipped()
+		return nil
+	}
+
+	tmpFile, err := ngx.writeTmpCfg(content)
+	if err != nil {
+		ngx.stats.reloadFailed(err)
+		return fmt.Errorf("failed to write new nginx configuration: %v", err)
+	}
+	// after the rename the temporary file does not exists and this is a no-op
+	defer os.Remove(tmpFile)
+
+	if err := ngx.testCfg(tmpFile); err != nil {
+		ngx.stats.reloadFailed(err)
+		return fmt.Errorf("invalid nginx configuration. Avoiding reload: %v", err)
 	}
 
-	if err := ngx.shellOut("nginx -s reload"); err == nil {
-		glog.Info("Change in configuration detected. Reloading...")
+	if err := os.Rename(tmpFile, ngx.ConfigFile); err != nil {
+		ngx.stats.reloadFailed(err)
+		return fmt.Errorf("failed to replace nginx configuration: %v", err)
 	}
+
+	if err := ngx.signal("reload"); err != nil {
+		ngx.stats.reloadFailed(err)
+		return err
+	}
+
+	glog.Infof("Change in configuration detected (%v). Reloading...", newSum)
+	ngx.stats.reloaded(newSum)
+	return nil
 }
 
-// shellOut executes a command and returns its combined standard output and standard
-// error in case of an error in the execution
-func (ngx *NginxManager) shellOut(cmd string) error {
-	out, err := exec.Command("sh", "-c", cmd).CombinedOutput()
+// writeTmpCfg writes the content of a configuration file in a temporary file located
+// in the same directory than the nginx configuration file (to allow an atomic rename)
+// and returns the path of the file
+func (ngx *NginxManager) writeTmpCfg(content []byte) (string, error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(ngx.ConfigFile), ".nginx-cfg")
 	if err != nil {
-		glog.Errorf("Failed to execute %v: %v", cmd, string(out))
-		return err
+		return "", err
+	}
+	defer tmp.Close()
+
+	if _, err := tmp.Write(content); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	return tmp.Name(), nil
+}
+
+// testCfg checks the syntax of a configuration file using "nginx -t"
+func (ngx *NginxManager) testCfg(cfgFile string) error {
+	out, err := ngx.command("-t", "-c", cfgFile).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%v: %v", err, string(out))
+	}
+
+	return nil
+}
+
+// signal sends a signal to the nginx master process using "nginx -s" and returns
+// the combined standard output and standard error in case of an error
+func (ngx *NginxManager) signal(sig string) error {
+	out, err := ngx.command("-s", sig).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to send %v signal to nginx: %v: %v", sig, err, string(out))
 	}
 
 	return nil
 }
+
+// nginxCommand returns the command that runs nginx with the arguments
+func nginxCommand(args ...string) *exec.Cmd {
+	return exec.Command("nginx", args...)
+}
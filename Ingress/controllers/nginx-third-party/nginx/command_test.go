@@ -0,0 +1,245 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"testing"
+	"text/template"
+	"time"
+)
+
+// fakeNginx returns a command that runs TestHelperProcess instead of nginx with
+// the behaviour set in env, e.g. FAIL_RELOAD=1.
+func fakeNginx(env ...string) func(args ...string) *exec.Cmd {
+	return func(args ...string) *exec.Cmd {
+		cmd := exec.Command(os.Args[0], append([]string{"-test.run=TestHelperProcess", "--"}, args...)...)
+		cmd.Env = append([]string{"GO_WANT_HELPER_PROCESS=1"}, env...)
+		return cmd
+	}
+}
+
+// TestHelperProcess isn't a real test, it is the nginx run by fakeNginx.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	args = args[1:]
+	switch {
+	case len(args) == 0:
+		// the master process, it writes its pid in PID_FILE and ends on SIGQUIT
+		if os.Getenv("MASTER_EXIT") == "1" {
+			fmt.Fprintln(os.Stderr, "nginx: [emerg] bind() to 0.0.0.0:80 failed")
+			os.Exit(1)
+		}
+		quit := make(chan os.Signal, 1)
+		if os.Getenv("IGNORE_QUIT") != "1" {
+			signal.Notify(quit, syscall.SIGQUIT)
+		} else {
+			signal.Ignore(syscall.SIGQUIT)
+		}
+		ioutil.WriteFile(os.Getenv("PID_FILE"), []byte(strconv.Itoa(os.Getpid())), 0644)
+		<-quit
+	case len(args) == 2 && args[0] == "-s" && args[1] == "quit":
+		for i := 0; i < 100; i++ {
+			if data, err := ioutil.ReadFile(os.Getenv("PID_FILE")); err == nil && len(data) != 0 {
+				pid, _ := strconv.Atoi(string(data))
+				syscall.Kill(pid, syscall.SIGQUIT)
+				os.Exit(0)
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		os.Exit(1)
+	case len(args) == 2 && args[0] == "-s" && args[1] == "reload" && os.Getenv("FAIL_RELOAD") == "1":
+		fmt.Fprintln(os.Stderr, "nginx: [error] invalid PID number")
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newTestManager(t *testing.T) (*NginxManager, func()) {
+	dir, err := ioutil.TempDir("", "nginx")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ngx := &NginxManager{
+		ConfigFile:  filepath.Join(dir, "nginx.conf"),
+		defCfg:      newDefaultNginxCfg(),
+		defResolver: "10.0.0.10",
+		template:    template.Must(template.New("nginx.tmpl").Parse("resolver {{ .defResolver }};\n")),
+		reloadLock:  &sync.Mutex{},
+		command:     fakeNginx(),
+		quitCh:      make(chan struct{}),
+	}
+	return ngx, func() { os.RemoveAll(dir) }
+}
+
+func TestReloadAfterFailure(t *testing.T) {
+	ngx, cleanup := newTestManager(t)
+	defer cleanup()
+
+	ngx.command = fakeNginx("FAIL_RELOAD=1")
+	if err := ngx.Reload(&nginxConfiguration{}, nil, nil); err == nil {
+		t.Fatalf("expected the reload to fail")
+	}
+	// the configuration file was replaced before the reload failed
+	if _, err := os.Stat(ngx.ConfigFile); err != nil {
+		t.Fatalf("expected the configuration file to be written: %v", err)
+	}
+
+	// the same configuration is reloaded again
+	ngx.command = fakeNginx()
+	if err := ngx.Reload(&nginxConfiguration{}, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats := ngx.ReloadStats(); stats.Reloads != 1 || stats.Skipped != 0 || stats.Failures != 1 {
+		t.Errorf("expected 1 reload after 1 failure, got %+v", stats)
+	}
+
+	// and skipped once it was applied
+	if err := ngx.Reload(&nginxConfiguration{}, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats := ngx.ReloadStats(); stats.Reloads != 1 || stats.Skipped != 1 {
+		t.Errorf("expected the reload to be skipped, got %+v", stats)
+	}
+}
+
+// waitForState waits until the master process is in the state.
+func waitForState(t *testing.T, ngx *NginxManager, state string) ProcessStatus {
+	for i := 0; i < 100; i++ {
+		if status := ngx.ProcessStatus(); status.State == state {
+			return status
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("expected the master process to be %v, got %+v", state, ngx.ProcessStatus())
+	return ProcessStatus{}
+}
+
+// startMaster runs Start in a goroutine and returns the channel of its result.
+func startMaster(ngx *NginxManager, restart bool) chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- ngx.Start(restart)
+	}()
+	return result
+}
+
+func expectStopped(t *testing.T, ngx *NginxManager, result chan error) {
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the supervision to stop")
+	}
+	if status := ngx.ProcessStatus(); status.State != ProcessStopped || status.PID != 0 {
+		t.Errorf("expected the master process to be stopped, got %+v", status)
+	}
+}
+
+func TestQuit(t *testing.T) {
+	ngx, cleanup := newTestManager(t)
+	defer cleanup()
+	ngx.command = fakeNginx("PID_FILE=" + filepath.Join(filepath.Dir(ngx.ConfigFile), "nginx.pid"))
+
+	result := startMaster(ngx, true)
+	if status := waitForState(t, ngx, ProcessRunning); status.PID == 0 {
+		t.Errorf("expected the pid of the master process, got %+v", status)
+	}
+	if err := ngx.Quit(5 * time.Second); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	expectStopped(t, ngx, result)
+}
+
+func TestQuitKillsAfterTimeout(t *testing.T) {
+	ngx, cleanup := newTestManager(t)
+	defer cleanup()
+	ngx.command = fakeNginx("PID_FILE="+filepath.Join(filepath.Dir(ngx.ConfigFile), "nginx.pid"), "IGNORE_QUIT=1")
+
+	result := startMaster(ngx, true)
+	waitForState(t, ngx, ProcessRunning)
+	if err := ngx.Quit(200 * time.Millisecond); err == nil {
+		t.Errorf("expected an error after killing the master process")
+	}
+	expectStopped(t, ngx, result)
+}
+
+func TestQuitBeforeStart(t *testing.T) {
+	ngx, cleanup := newTestManager(t)
+	defer cleanup()
+	ngx.command = fakeNginx("PID_FILE=" + filepath.Join(filepath.Dir(ngx.ConfigFile), "nginx.pid"))
+
+	if err := ngx.Quit(time.Second); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// the master process isn't started after Quit
+	expectStopped(t, ngx, startMaster(ngx, true))
+}
+
+func TestRestart(t *testing.T) {
+	ngx, cleanup := newTestManager(t)
+	defer cleanup()
+	ngx.command = fakeNginx("MASTER_EXIT=1")
+
+	result := startMaster(ngx, true)
+	// the first restart is after the initial backoff
+	for i := 0; i < 100 && ngx.ProcessStatus().Restarts == 0; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	status := ngx.ProcessStatus()
+	if status.Restarts == 0 || status.LastExitError == "" {
+		t.Fatalf("expected the master process to be restarted, got %+v", status)
+	}
+	// Quit interrupts the backoff
+	waitForState(t, ngx, ProcessRestarting)
+	if err := ngx.Quit(time.Second); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	expectStopped(t, ngx, result)
+
+	// without restart the error is returned
+	ngx, cleanup = newTestManager(t)
+	defer cleanup()
+	ngx.command = fakeNginx("MASTER_EXIT=1")
+	select {
+	case err := <-startMaster(ngx, false):
+		if err == nil {
+			t.Errorf("expected the error of the master process")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the supervision to stop")
+	}
+	if status := ngx.ProcessStatus(); status.State != ProcessExited || status.Restarts != 0 {
+		t.Errorf("expected the master process to be exited, got %+v", status)
+	}
+}
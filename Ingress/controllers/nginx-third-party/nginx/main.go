@@ -17,6 +17,7 @@ limitations under the License.
 package nginx
 
 import (
+	"os/exec"
 	"runtime"
 	"strconv"
 	"strings"
@@ -204,7 +205,7 @@ type Service struct {
 	ServiceName string
 	ServicePort string
 	Namespace   string
-	// ExposedPort port used by nginx to listen for the stream upstream
+	// ExposedPort port used by nginx to listen for the stream (TCP or UDP) upstream
 	ExposedPort string
 }
 
@@ -220,7 +221,6 @@ type NginxManager struct {
 
 	sslCertificates []ssl.Certificate
 	sslDHParam      string
-	servicesL4      []Service
 
 	client *client.Client
 	// template loaded ready to be used to generate the nginx configuration file
@@ -232,6 +232,26 @@ type NginxManager struct {
 	recorder record.EventRecorder
 
 	reloadLock *sync.Mutex
+
+	// command returns the command that runs nginx with the arguments
+	command func(args ...string) *exec.Cmd
+
+	// stats of the reloads exposed in the healthz server
+	stats reloadStats
+
+	// status of the nginx master process exposed in the healthz server
+	process processStatus
+
+	// processLock protects the running master process and the quit state
+	processLock sync.Mutex
+	master      *exec.Cmd
+	masterDone  chan struct{}
+	quitting    bool
+	quitCh      chan struct{}
+
+	// last routing table sent to nginx
+	tableLock    sync.Mutex
+	routingTable *RoutingTable
 }
 
 // defaultConfiguration returns the default configuration contained
@@ -274,6 +294,8 @@ func NewManager(kubeClient *client.Client, defaultSvc, customErrorSvc Service) *
 		defError:        customErrorSvc,
 		defResolver:     strings.Join(getDnsServers(), " "),
 		reloadLock:      &sync.Mutex{},
+		command:         nginxCommand,
+		quitCh:          make(chan struct{}),
 		sslDHParam:      ssl.SearchDHParamFile(sslDirectory),
 		sslCertificates: ssl.CreateSSLCerts(sslDirectory),
 	}
@@ -0,0 +1,303 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+const (
+	// PathTypeAnnotation is the Ingress annotation that defines how the paths of
+	// the rules are matched against the request URI.
+	PathTypeAnnotation = "nginx-ingress.kubernetes.io/path-type"
+
+	// PathTypePrefix matches the path as a prefix of the URI split by "/".
+	// /foo matches /foo and /foo/bar but not /foobar. This is the default.
+	PathTypePrefix = "prefix"
+	// PathTypeExact matches only if the URI is equal to the path
+	PathTypeExact = "exact"
+	// PathTypeRegex matches if the URI matches the path as a regular expression
+	PathTypeRegex = "regex"
+
+	clusterDomain = "cluster.local"
+)
+
+// Backend is a service that receives the requests
+type Backend struct {
+	// Host is the DNS name of the service
+	Host string `json:"host"`
+	// Port is the port of the service (number or name)
+	Port string `json:"port"`
+	// Ingress is the namespace/name of the Ingress that defines this backend
+	Ingress string `json:"ingress"`
+}
+
+// Location maps a path to a backend
+type Location struct {
+	Path    string  `json:"path"`
+	Type    string  `json:"type"`
+	Backend Backend `json:"backend"`
+}
+
+// Server contains the locations of a host. The locations are sorted in the
+// order they must be checked: exact paths first, then regular expressions
+// and then prefixes from the longest to the shortest.
+type Server struct {
+	Host      string     `json:"host"`
+	Locations []Location `json:"locations"`
+	// DefaultBackend is the Spec.Backend of the Ingress that first defined
+	// the host. Used when no location matches the URI.
+	DefaultBackend *Backend `json:"defaultBackend,omitempty"`
+}
+
+// RoutingTable is the canonical representation of all the Ingress rules.
+// The lua code in nginx only needs to look for the server and walk the
+// locations:
+//   - Servers contains the exact hosts.
+//   - Wildcard hosts (*.bar.com) are also stored in Servers. A wildcard
+//     matches exactly one label (foo.bar.com but not foo.baz.bar.com).
+//   - The rules without host are stored in the server with empty host and
+//     are used only if there is no exact or wildcard match for the host.
+//   - DefaultBackend is the Spec.Backend of the oldest Ingress that defines
+//     one. If there is none the default backend service is used.
+type RoutingTable struct {
+	Servers        map[string]*Server `json:"servers"`
+	DefaultBackend *Backend           `json:"defaultBackend,omitempty"`
+	// Conflicts contains the rules that were ignored because other
+	// (older) Ingress already defined the same host and path.
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// byCreationTimestamp sorts Ingress from the oldest to the newest.
+// If two Ingress have the same creation time namespace/name is used.
+type byCreationTimestamp []*extensions.Ingress
+
+func (o byCreationTimestamp) Len() int      { return len(o) }
+func (o byCreationTimestamp) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o byCreationTimestamp) Less(i, j int) bool {
+	ti := o[i].CreationTimestamp
+	tj := o[j].CreationTimestamp
+	if ti.Equal(tj) {
+		return ingressKey(o[i]) < ingressKey(o[j])
+	}
+	return ti.Before(tj)
+}
+
+// NewRoutingTable creates a routing table from a list of Ingress.
+// Conflicts between Ingress (the same host and path defined in more than one
+// Ingress) are resolved using the creation time: the oldest Ingress wins.
+func NewRoutingTable(ings []*extensions.Ingress) *RoutingTable {
+	sorted := make([]*extensions.Ingress, len(ings))
+	copy(sorted, ings)
+	sort.Sort(byCreationTimestamp(sorted))
+
+	table := &RoutingTable{
+		Servers: map[string]*Server{},
+	}
+
+	for _, ing := range sorted {
+		pathType, err := getPathType(ing)
+		if err != nil {
+			table.Conflicts = append(table.Conflicts, err.Error())
+			continue
+		}
+
+		var ingBackend *Backend
+		if ing.Spec.Backend != nil {
+			ingBackend = newBackend(ing, ing.Spec.Backend)
+			if table.DefaultBackend == nil {
+				table.DefaultBackend = ingBackend
+			} else {
+				table.Conflicts = append(table.Conflicts, fmt.Sprintf("ingress %v: default backend already defined by ingress %v",
+					ingressKey(ing), table.DefaultBackend.Ingress))
+			}
+		}
+
+		for _, rule := range ing.Spec.Rules {
+			host := strings.ToLower(rule.Host)
+			server, ok := table.Servers[host]
+			if !ok {
+				server = &Server{Host: host, Locations: []Location{}}
+				table.Servers[host] = server
+			}
+
+			if server.DefaultBackend == nil && ingBackend != nil {
+				server.DefaultBackend = ingBackend
+			}
+
+			if rule.HTTP == nil {
+				continue
+			}
+
+			for _, path := range rule.HTTP.Paths {
+				loc := Location{
+					Path:    path.Path,
+					Type:    pathType,
+					Backend: *newBackend(ing, &path.Backend),
+				}
+				if loc.Path == "" {
+					loc.Path = "/"
+				}
+
+				if pathType == PathTypeRegex {
+					if _, err := regexp.Compile(loc.Path); err != nil {
+						table.Conflicts = append(table.Conflicts, fmt.Sprintf("ingress %v: invalid regular expression %v: %v",
+							ingressKey(ing), loc.Path, err))
+						continue
+					}
+				}
+
+				if prev := server.location(loc.Path, loc.Type); prev != nil {
+					table.Conflicts = append(table.Conflicts, fmt.Sprintf("ingress %v: host %q path %v (%v) already defined by ingress %v",
+						ingressKey(ing), host, loc.Path, loc.Type, prev.Backend.Ingress))
+					continue
+				}
+
+				server.Locations = append(server.Locations, loc)
+			}
+		}
+	}
+
+	for _, server := range table.Servers {
+		sort.Stable(byMatchOrder(server.Locations))
+	}
+
+	return table
+}
+
+// location returns the location with the same path and type or nil
+func (s *Server) location(path, pathType string) *Location {
+	for i := range s.Locations {
+		if s.Locations[i].Path == path && s.Locations[i].Type == pathType {
+			return &s.Locations[i]
+		}
+	}
+
+	return nil
+}
+
+// Match returns the backend that must serve a request to host and uri or
+// nil if the request must be served by the default backend service.
+// This is the same logic used in ingress.lua and is used only for testing
+// and debugging.
+func (t *RoutingTable) Match(host, uri string) *Backend {
+	host = strings.ToLower(host)
+	if i := strings.Index(host, ":"); i != -1 {
+		host = host[:i]
+	}
+
+	server, ok := t.Servers[host]
+	if !ok {
+		if i := strings.Index(host, "."); i != -1 {
+			server, ok = t.Servers["*"+host[i:]]
+		}
+	}
+	if !ok {
+		server, ok = t.Servers[""]
+	}
+
+	if ok {
+		for _, loc := range server.Locations {
+			if loc.matches(uri) {
+				return &loc.Backend
+			}
+		}
+
+		if server.DefaultBackend != nil {
+			return server.DefaultBackend
+		}
+	}
+
+	return t.DefaultBackend
+}
+
+// matches checks if the uri is matched by the location
+func (l Location) matches(uri string) bool {
+	switch l.Type {
+	case PathTypeExact:
+		return uri == l.Path
+	case PathTypeRegex:
+		re, err := regexp.Compile(l.Path)
+		return err == nil && re.MatchString(uri)
+	default:
+		if l.Path == "/" || uri == l.Path {
+			return true
+		}
+		return strings.HasPrefix(uri, strings.TrimSuffix(l.Path, "/")+"/")
+	}
+}
+
+// byMatchOrder sorts locations: exact paths, regular expressions (in the
+// order they were defined) and prefixes from the longest to the shortest.
+type byMatchOrder []Location
+
+func (o byMatchOrder) Len() int      { return len(o) }
+func (o byMatchOrder) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o byMatchOrder) Less(i, j int) bool {
+	ri, rj := pathTypeRank(o[i].Type), pathTypeRank(o[j].Type)
+	if ri != rj {
+		return ri < rj
+	}
+	if o[i].Type == PathTypePrefix {
+		return len(o[i].Path) > len(o[j].Path)
+	}
+	return false
+}
+
+func pathTypeRank(pathType string) int {
+	switch pathType {
+	case PathTypeExact:
+		return 0
+	case PathTypeRegex:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// getPathType returns the path type defined in the Ingress annotations
+func getPathType(ing *extensions.Ingress) (string, error) {
+	pathType, ok := ing.Annotations[PathTypeAnnotation]
+	if !ok || pathType == "" {
+		return PathTypePrefix, nil
+	}
+
+	pathType = strings.ToLower(pathType)
+	switch pathType {
+	case PathTypePrefix, PathTypeExact, PathTypeRegex:
+		return pathType, nil
+	}
+
+	return "", fmt.Errorf("ingress %v: invalid path type %q", ingressKey(ing), pathType)
+}
+
+func newBackend(ing *extensions.Ingress, backend *extensions.IngressBackend) *Backend {
+	return &Backend{
+		Host:    fmt.Sprintf("%v.%v.svc.%v", backend.ServiceName, ing.Namespace, clusterDomain),
+		Port:    backend.ServicePort.String(),
+		Ingress: ingressKey(ing),
+	}
+}
+
+func ingressKey(ing *extensions.Ingress) string {
+	return fmt.Sprintf("%v/%v", ing.Namespace, ing.Name)
+}
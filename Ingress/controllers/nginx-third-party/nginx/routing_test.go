@@ -0,0 +1,264 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+var testTime = time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newIngress creates an Ingress created age minutes after testTime using
+// rules in the form host -> path -> service.
+func newIngress(name string, age int, defSvc string, rules map[string]map[string]string, annotations map[string]string) *extensions.Ingress {
+	ing := &extensions.Ingress{
+		ObjectMeta: api.ObjectMeta{
+			Name:              name,
+			Namespace:         api.NamespaceDefault,
+			CreationTimestamp: unversioned.NewTime(testTime.Add(time.Duration(age) * time.Minute)),
+			Annotations:       annotations,
+		},
+	}
+
+	if defSvc != "" {
+		ing.Spec.Backend = &extensions.IngressBackend{
+			ServiceName: defSvc,
+			ServicePort: intstr.FromInt(80),
+		}
+	}
+
+	for host, paths := range rules {
+		httpPaths := []extensions.HTTPIngressPath{}
+		for path, svc := range paths {
+			httpPaths = append(httpPaths, extensions.HTTPIngressPath{
+				Path: path,
+				Backend: extensions.IngressBackend{
+					ServiceName: svc,
+					ServicePort: intstr.FromInt(80),
+				},
+			})
+		}
+		ing.Spec.Rules = append(ing.Spec.Rules, extensions.IngressRule{
+			Host: host,
+			IngressRuleValue: extensions.IngressRuleValue{
+				HTTP: &extensions.HTTPIngressRuleValue{
+					Paths: httpPaths,
+				},
+			},
+		})
+	}
+
+	return ing
+}
+
+func svcHost(name string) string {
+	return name + ".default.svc.cluster.local"
+}
+
+func TestRoutingTableMatch(t *testing.T) {
+	testCases := []struct {
+		desc string
+		ings []*extensions.Ingress
+		// host -> uri -> expected service (empty means default backend service)
+		expected map[string]map[string]string
+		// number of expected conflicts
+		conflicts int
+	}{
+		{
+			desc: "prefix paths use the longest match",
+			ings: []*extensions.Ingress{
+				newIngress("a", 0, "", map[string]map[string]string{
+					"foo.bar.com": {"/": "root", "/foo": "foo", "/foo/bar": "foobar"},
+				}, nil),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/": "root", "/foo": "foo", "/foo/": "foo", "/foo/baz": "foo", "/foobar": "root", "/foo/bar/baz": "foobar"},
+				"bar.baz.com": {"/": ""},
+			},
+		},
+		{
+			desc: "exact paths",
+			ings: []*extensions.Ingress{
+				newIngress("a", 0, "", map[string]map[string]string{
+					"foo.bar.com": {"/foo": "foo"},
+				}, map[string]string{PathTypeAnnotation: PathTypeExact}),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/foo": "foo", "/foo/bar": "", "/": ""},
+			},
+		},
+		{
+			desc: "regular expressions",
+			ings: []*extensions.Ingress{
+				newIngress("a", 0, "", map[string]map[string]string{
+					"foo.bar.com": {"^/api/v[0-9]+/": "api"},
+				}, map[string]string{PathTypeAnnotation: PathTypeRegex}),
+				newIngress("b", 1, "", map[string]map[string]string{
+					"foo.bar.com": {"/": "root"},
+				}, nil),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/api/v1/pods": "api", "/api/beta/pods": "root"},
+			},
+		},
+		{
+			desc: "invalid regular expressions and path types are ignored",
+			ings: []*extensions.Ingress{
+				newIngress("a", 0, "", map[string]map[string]string{
+					"foo.bar.com": {"^/api/(": "api"},
+				}, map[string]string{PathTypeAnnotation: PathTypeRegex}),
+				newIngress("b", 1, "", map[string]map[string]string{
+					"foo.bar.com": {"/": "root"},
+				}, map[string]string{PathTypeAnnotation: "glob"}),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/api/(": "", "/": ""},
+			},
+			conflicts: 2,
+		},
+		{
+			desc: "the oldest ingress wins",
+			ings: []*extensions.Ingress{
+				newIngress("newer", 10, "", map[string]map[string]string{
+					"foo.bar.com": {"/foo": "newer", "/bar": "bar"},
+				}, nil),
+				newIngress("older", 5, "", map[string]map[string]string{
+					"foo.bar.com": {"/foo": "older"},
+				}, nil),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/foo": "older", "/bar": "bar"},
+			},
+			conflicts: 1,
+		},
+		{
+			desc: "same creation time uses the name",
+			ings: []*extensions.Ingress{
+				newIngress("b", 0, "", map[string]map[string]string{
+					"foo.bar.com": {"/foo": "b"},
+				}, nil),
+				newIngress("a", 0, "", map[string]map[string]string{
+					"foo.bar.com": {"/foo": "a"},
+				}, nil),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/foo": "a"},
+			},
+			conflicts: 1,
+		},
+		{
+			desc: "default backends",
+			ings: []*extensions.Ingress{
+				newIngress("a", 0, "default-a", map[string]map[string]string{
+					"foo.bar.com": {"/foo": "foo"},
+				}, nil),
+				newIngress("b", 1, "default-b", map[string]map[string]string{
+					"bar.baz.com": {"/bar": "bar"},
+				}, nil),
+				newIngress("c", 2, "", map[string]map[string]string{
+					"baz.com": {"/baz": "baz"},
+				}, nil),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com": {"/foo": "foo", "/other": "default-a"},
+				"bar.baz.com": {"/bar": "bar", "/other": "default-b"},
+				"baz.com":     {"/baz": "baz", "/other": "default-a"},
+				"unknown.com": {"/": "default-a"},
+			},
+			conflicts: 1,
+		},
+		{
+			desc: "wildcard hosts and rules without host",
+			ings: []*extensions.Ingress{
+				newIngress("a", 0, "", map[string]map[string]string{
+					"*.bar.com":   {"/": "wildcard"},
+					"foo.bar.com": {"/": "foo"},
+					"":            {"/": "any"},
+				}, nil),
+			},
+			expected: map[string]map[string]string{
+				"foo.bar.com":      {"/": "foo"},
+				"FOO.BAR.COM":      {"/": "foo"},
+				"baz.bar.com":      {"/": "wildcard"},
+				"baz.bar.com:8080": {"/": "wildcard"},
+				"a.baz.bar.com":    {"/": "any"},
+				"bar.com":          {"/": "any"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		table := NewRoutingTable(tc.ings)
+		if len(table.Conflicts) != tc.conflicts {
+			t.Errorf("%v: expected %v conflicts but returned %v: %v", tc.desc, tc.conflicts, len(table.Conflicts), table.Conflicts)
+		}
+
+		for host, uris := range tc.expected {
+			for uri, svc := range uris {
+				backend := table.Match(host, uri)
+				if svc == "" {
+					if backend != nil {
+						t.Errorf("%v: expected default backend for %v%v but returned %v", tc.desc, host, uri, backend.Host)
+					}
+					continue
+				}
+
+				if backend == nil {
+					t.Errorf("%v: expected %v for %v%v but returned the default backend", tc.desc, svc, host, uri)
+					continue
+				}
+				if backend.Host != svcHost(svc) {
+					t.Errorf("%v: expected %v for %v%v but returned %v", tc.desc, svcHost(svc), host, uri, backend.Host)
+				}
+			}
+		}
+	}
+}
+
+func TestRoutingTableLocationOrder(t *testing.T) {
+	ing := newIngress("a", 0, "", map[string]map[string]string{
+		"foo.bar.com": {"/": "root", "/foo/bar": "foobar", "/foo": "foo"},
+	}, nil)
+	exact := newIngress("b", 1, "", map[string]map[string]string{
+		"foo.bar.com": {"/foo": "exact"},
+	}, map[string]string{PathTypeAnnotation: PathTypeExact})
+
+	table := NewRoutingTable([]*extensions.Ingress{ing, exact})
+	server, ok := table.Servers["foo.bar.com"]
+	if !ok {
+		t.Fatalf("expected server foo.bar.com")
+	}
+
+	expected := []string{"/foo", "/foo/bar", "/foo", "/"}
+	if len(server.Locations) != len(expected) {
+		t.Fatalf("expected %v locations but returned %v", len(expected), len(server.Locations))
+	}
+	for i, path := range expected {
+		if server.Locations[i].Path != path {
+			t.Errorf("expected location %v to be %v but returned %v", i, path, server.Locations[i].Path)
+		}
+	}
+	if server.Locations[0].Type != PathTypeExact {
+		t.Errorf("expected the exact location first but returned %v", server.Locations[0].Type)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// ReloadStats contains information about the reloads of the nginx configuration
+type ReloadStats struct {
+	// Reloads number of successful reloads
+	Reloads int `json:"reloads"`
+	// Skipped number of reloads avoided because the configuration did not change
+	Skipped int `json:"skipped"`
+	// Failures number of configurations that could not be generated, validated or applied
+	Failures int `json:"failures"`
+	// LastChecksum sha1 of the last configuration file successfully applied
+	LastChecksum string `json:"lastChecksum"`
+	// LastReload time of the last successful reload
+	LastReload time.Time `json:"lastReload"`
+	// LastError error returned by the last failed reload
+	LastError string `json:"lastError,omitempty"`
+}
+
+// reloadStats keeps the ReloadStats safe to be read from the healthz server
+type reloadStats struct {
+	lock  sync.Mutex
+	stats ReloadStats
+}
+
+func (r *reloadStats) reloaded(checksum string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.stats.Reloads++
+	r.stats.LastChecksum = checksum
+	r.stats.LastReload = time.Now()
+}
+
+func (r *reloadStats) reloadSkipped() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.stats.Skipped++
+}
+
+func (r *reloadStats) reloadFailed(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.stats.Failures++
+	r.stats.LastError = err.Error()
+}
+
+func (r *reloadStats) get() ReloadStats {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.stats
+}
+
+// ReloadStats returns a copy of the statistics of the nginx reloads
+func (ngx *NginxManager) ReloadStats() ReloadStats {
+	return ngx.stats.get()
+}
+
+// checksum returns the sha1 of the content of a configuration file
+func checksum(content []byte) string {
+	h := sha1.New()
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+const (
+	// ProcessStarting nginx master process is being started
+	ProcessStarting = "starting"
+	// ProcessRunning nginx master process is running
+	ProcessRunning = "running"
+	// ProcessRestarting nginx master process terminated and will be started again
+	ProcessRestarting = "restarting"
+	// ProcessStopping nginx master process received the quit signal and is draining connections
+	ProcessStopping = "stopping"
+	// ProcessStopped nginx master process terminated after a quit signal
+	ProcessStopped = "stopped"
+	// ProcessExited nginx master process terminated and will not be started again
+	ProcessExited = "exited"
+)
+
+// ProcessStatus contains information about the nginx master process
+type ProcessStatus struct {
+	// State current state of the master process
+	State string `json:"state"`
+	// PID of the master process. Zero if the process is not running
+	PID int `json:"pid"`
+	// Restarts number of times the master process was started after a failure
+	Restarts int `json:"restarts"`
+	// StartedAt time when the current master process was started
+	StartedAt time.Time `json:"startedAt"`
+	// LastExitError error returned by the last master process that terminated
+	LastExitError string `json:"lastExitError,omitempty"`
+}
+
+// processStatus keeps the ProcessStatus safe to be read from the healthz server
+type processStatus struct {
+	lock   sync.Mutex
+	status ProcessStatus
+}
+
+func (p *processStatus) setState(state string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.status.State = state
+}
+
+func (p *processStatus) started(pid int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.status.State = ProcessRunning
+	p.status.PID = pid
+	p.status.StartedAt = time.Now()
+}
+
+func (p *processStatus) exited(state string, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.status.State = state
+	p.status.PID = 0
+	if err != nil {
+		p.status.LastExitError = err.Error()
+	}
+}
+
+func (p *processStatus) restarted() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.status.Restarts++
+}
+
+func (p *processStatus) get() ProcessStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.status
+}
+
+// ProcessStatus returns a copy of the status of the nginx master process
+func (ngx *NginxManager) ProcessStatus() ProcessStatus {
+	return ngx.process.get()
+}
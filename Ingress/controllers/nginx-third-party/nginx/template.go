@@ -17,10 +17,10 @@ limitations under the License.
 package nginx
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"text/template"
 
 	"github.com/fatih/structs"
@@ -60,19 +60,17 @@ func (ngx *NginxManager) loadTemplate() {
 	ngx.template = tmpl
 }
 
-func (ngx *NginxManager) writeCfg(cfg *nginxConfiguration, servicesL4 []Service) error {
-	file, err := os.Create(ngx.ConfigFile)
-	if err != nil {
-		return err
-	}
-
+// generateCfg renders the nginx template in memory using the custom configuration
+// merged with the defaults and returns the content of the configuration file.
+func (ngx *NginxManager) generateCfg(cfg *nginxConfiguration, tcpServices, udpServices []Service) ([]byte, error) {
 	fromMap := structs.Map(cfg)
 	toMap := structs.Map(ngx.defCfg)
 	curNginxCfg := merge(toMap, fromMap)
 
 	conf := make(map[string]interface{})
 	conf["sslCertificates"] = ngx.sslCertificates
-	conf["tcpServices"] = servicesL4
+	conf["tcpServices"] = tcpServices
+	conf["udpServices"] = udpServices
 	conf["defBackend"] = ngx.defBackend
 	conf["defResolver"] = ngx.defResolver
 	conf["sslDHParam"] = ngx.sslDHParam
@@ -92,10 +90,10 @@ func (ngx *NginxManager) writeCfg(cfg *nginxConfiguration, servicesL4 []Service)
 		glog.Infof("nginx configuration: %v", string(b))
 	}
 
-	err = ngx.template.Execute(file, conf)
-	if err != nil {
-		return err
+	var buf bytes.Buffer
+	if err := ngx.template.Execute(&buf, conf); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return buf.Bytes(), nil
 }
@@ -28,10 +28,18 @@ import (
 	"github.com/golang/glog"
 )
 
-// SyncIngress creates a GET request to nginx to indicate that is required to refresh the Ingress rules.
-func (ngx *NginxManager) SyncIngress(ingList []interface{}) error {
-	encData, _ := json.Marshal(ingList)
+// SyncIngress sends the routing table built from the Ingress rules to nginx using
+// a POST request. There is no need to reload nginx, the rules are used from ingress.lua
+func (ngx *NginxManager) SyncIngress(table *RoutingTable) error {
+	encData, err := json.Marshal(table)
+	if err != nil {
+		return err
+	}
+
 	req, err := http.NewRequest("POST", "http://127.0.0.1:8080/update-ingress", bytes.NewBuffer(encData))
+	if err != nil {
+		return err
+	}
 	req.Header.Set("Content-Type", "application/json")
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -45,8 +53,18 @@ func (ngx *NginxManager) SyncIngress(ingList []interface{}) error {
 		return fmt.Errorf("nginx status is unhealthy")
 	}
 
+	ngx.tableLock.Lock()
+	defer ngx.tableLock.Unlock()
+	ngx.routingTable = table
+
 	return nil
+}
 
+// RoutingTable returns the last routing table sent to nginx
+func (ngx *NginxManager) RoutingTable() *RoutingTable {
+	ngx.tableLock.Lock()
+	defer ngx.tableLock.Unlock()
+	return ngx.routingTable
 }
 
 // IsHealthy checks if nginx is running
@@ -18,6 +18,7 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -198,22 +199,22 @@ func getServicePorts(kubeClient *unversioned.Client, ns, name string) (ports []s
 	return
 }
 
-func getTcpServices(kubeClient *unversioned.Client, tcpServices string) []nginx.Service {
-	svcs := []nginx.Service{}
-	for _, svc := range strings.Split(tcpServices, ",") {
-		if svc == "" {
-			continue
-		}
+// parseNsName parses a string in the form namespace/name
+func parseNsName(input string) (string, string, error) {
+	nsName := strings.Split(input, "/")
+	if len(nsName) != 2 || nsName[0] == "" || nsName[1] == "" {
+		return "", "", fmt.Errorf("invalid format (namespace/name) found in '%v'", input)
+	}
 
-		namePort := strings.Split(svc, ":")
-		if len(namePort) == 2 {
-			tcpSvc := getService(kubeClient, namePort[0])
-			tcpSvc.ExposedPort = namePort[1]
-			svcs = append(svcs, tcpSvc)
-		} else {
-			glog.Errorf("TCP services should take the form namespace/name:port not %v from %v", namePort, svc)
-		}
+	return nsName[0], nsName[1], nil
+}
+
+// splitServicePort parses a string in the form namespace/name:port
+func splitServicePort(input string) (string, string, error) {
+	namePort := strings.Split(input, ":")
+	if len(namePort) != 2 || namePort[1] == "" {
+		return "", "", fmt.Errorf("invalid format (namespace/name:port) found in '%v'", input)
 	}
 
-	return svcs
+	return namePort[0], namePort[1], nil
 }
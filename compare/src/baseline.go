@@ -0,0 +1,267 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"k8s.io/kubernetes/pkg/metrics"
+	"k8s.io/kubernetes/test/e2e"
+
+	"github.com/golang/glog"
+)
+
+// BuildSummaries contains the summaries found in the log of a single build, keyed by test name.
+type BuildSummaries struct {
+	Build     int
+	Logs      map[string]*e2e.LogsSizeDataSummary
+	Resources map[string]*e2e.ResourceUsageSummary
+	Metrics   map[string]*e2e.MetricsForE2E
+	// Errors contains the summaries that couldn't be extracted from the log
+	Errors []*SummaryError
+}
+
+// seriesKey identifies a single value in the summaries of a test.
+type seriesKey struct {
+	kind      string
+	name      string
+	component string
+	labels    string
+}
+
+type seriesValues map[seriesKey]float64
+
+func aggregateLabels(labels, aggregate string) string {
+	if labels == "" {
+		return "aggregate=" + aggregate
+	}
+	return fmt.Sprintf("%v, aggregate=%v", labels, aggregate)
+}
+
+// flattenLogs returns the minimal and maximal generation rate of every log file.
+func flattenLogs(data *e2e.LogsSizeDataSummary) seriesValues {
+	result := make(seriesValues)
+	if data == nil {
+		return result
+	}
+	for file, arr := range computeLogsAggregates(data) {
+		result[seriesKey{kind: LogsKind, name: file, labels: "aggregate=min"}] = arr[0].generationRate
+		result[seriesKey{kind: LogsKind, name: file, labels: "aggregate=max"}] = arr[len(arr)-1].generationRate
+	}
+	return result
+}
+
+// flattenResources returns the minimal and maximal CPU and memory usage of every container kind
+// and percentile.
+func flattenResources(data *e2e.ResourceUsageSummary) seriesValues {
+	result := make(seriesValues)
+	if data == nil {
+		return result
+	}
+	for container, percentiles := range computeResourceAggregates(data) {
+		for _, p := range percentiles {
+			sort.Float64s(p.cpuData)
+			sort.Sort(int64arr(p.memData))
+			labels := "percentile=" + p.percentile
+			if len(p.cpuData) != 0 {
+				result[seriesKey{ResourcesKind, container, "cpu", aggregateLabels(labels, "min")}] = p.cpuData[0]
+				result[seriesKey{ResourcesKind, container, "cpu", aggregateLabels(labels, "max")}] = p.cpuData[len(p.cpuData)-1]
+			}
+			if len(p.memData) != 0 {
+				result[seriesKey{ResourcesKind, container, "memory", aggregateLabels(labels, "min")}] = float64(p.memData[0])
+				result[seriesKey{ResourcesKind, container, "memory", aggregateLabels(labels, "max")}] = float64(p.memData[len(p.memData)-1])
+			}
+		}
+	}
+	return result
+}
+
+// flattenMetrics returns the value of every metric of the components. For kubelet metrics the
+// minimal and maximal values over all Kubelets are returned.
+func flattenMetrics(data *e2e.MetricsForE2E) seriesValues {
+	result := make(seriesValues)
+	if data == nil {
+		return result
+	}
+	components := map[string]metrics.Metrics{
+		"ApiServer":         metrics.Metrics(data.ApiServerMetrics),
+		"ControllerManager": metrics.Metrics(data.ControllerManagerMetrics),
+		"Scheduler":         metrics.Metrics(data.SchedulerMetrics),
+	}
+	for component, componentMetrics := range components {
+		for k := range componentMetrics {
+			samples := componentMetrics[k]
+			for metric, buckets := range *flattenSamples(&samples) {
+				for labels, value := range buckets {
+					result[seriesKey{MetricsKind, metric, component, labels}] = value
+				}
+			}
+		}
+	}
+	for metric, buckets := range *computeKubeletAggregates(data.KubeletMetrics) {
+		for labels, arr := range buckets {
+			sort.Sort(arr)
+			result[seriesKey{MetricsKind, metric, KubeletComponent, aggregateLabels(labels, "min")}] = arr[0].value
+			result[seriesKey{MetricsKind, metric, KubeletComponent, aggregateLabels(labels, "max")}] = arr[len(arr)-1].value
+		}
+	}
+	return result
+}
+
+// threshold returns the threshold of the policy that applies to the series.
+func (k seriesKey) threshold(policy *Policy) Threshold {
+	switch k.kind {
+	case LogsKind:
+		return policy.LogThreshold(k.name)
+	case ResourcesKind:
+		if k.component == "memory" {
+			return policy.ResourceThreshold(k.name).Memory
+		}
+		return policy.ResourceThreshold(k.name).CPU
+	default:
+		return policy.MetricThreshold(k.name, k.component)
+	}
+}
+
+// meanAndStddev returns the mean and the sample standard deviation of the values.
+func meanAndStddev(values []float64) (float64, float64) {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)-1))
+}
+
+// isOutsideBaseline checks if value is significantly different from the baseline values: it must be
+// more than maxDeviations standard deviations away from the mean and not within the allowed variance
+// of the threshold from the mean. Returns the mean, the standard deviation and the result.
+func isOutsideBaseline(baseline []float64, value float64, threshold Threshold, maxDeviations float64) (float64, float64, bool) {
+	mean, stddev := meanAndStddev(baseline)
+	if threshold.similarEnough(mean, value, threshold.allowedVariance()) {
+		return mean, stddev, false
+	}
+	diff := value - mean
+	if threshold.Direction == DirectionIncrease && diff <= 0 {
+		return mean, stddev, false
+	}
+	return mean, stddev, math.Abs(diff) > maxDeviations*stddev
+}
+
+// compareSeries compares the values of the candidate against the baseline values of the same series.
+func compareSeries(test string, baseline []seriesValues, candidate seriesValues, policy *Policy, maxDeviations float64) []Violation {
+	violations := []Violation{}
+	for key, value := range candidate {
+		values := []float64{}
+		for _, b := range baseline {
+			if v, ok := b[key]; ok {
+				values = append(values, v)
+			}
+		}
+		if len(values) < minBaselineBuilds {
+			glog.V(4).Infof("Not enough baseline data for %v %v %v {%v} in test %v", key.kind, key.name, key.component, key.labels, test)
+			continue
+		}
+		mean, stddev, outside := isOutsideBaseline(values, value, key.threshold(policy), maxDeviations)
+		if !outside {
+			continue
+		}
+		violations = append(violations, Violation{
+			Test:      test,
+			Kind:      key.kind,
+			Name:      key.name,
+			Component: key.component,
+			Labels:    key.labels,
+			Left:      mean,
+			Right:     value,
+			Stddev:    float64Ptr(stddev),
+		})
+	}
+	sort.Sort(violationArr(violations))
+	return violations
+}
+
+// minBaselineBuilds is the minimal number of baseline builds with data for a value to compare it.
+const minBaselineBuilds = 2
+
+// CompareWithBaseline compares every value in the candidate build against the same value in the
+// baseline builds. A value is flagged if it is more than maxDeviations standard deviations away from
+// the mean of the baseline and it is not within the allowed variance of the policy from the mean.
+// Values need data from at least two baseline builds.
+func CompareWithBaseline(baseline []*BuildSummaries, candidate *BuildSummaries, policy *Policy, maxDeviations float64) *Report {
+	report := NewReport(0, candidate.Build)
+	for _, b := range baseline {
+		report.BaselineBuilds = append(report.BaselineBuilds, b.Build)
+	}
+	report.AddSummaryErrors(append(baseline, candidate)...)
+
+	compareKind := func(kind string, tests []string, flatten func(b *BuildSummaries, test string) (seriesValues, bool)) {
+		sort.Strings(tests)
+		for _, test := range tests {
+			values := []seriesValues{}
+			for _, b := range baseline {
+				if v, ok := flatten(b, test); ok {
+					values = append(values, v)
+				}
+			}
+			if len(values) == 0 {
+				report.AddMissing(test, kind)
+				continue
+			}
+			candidateValues, _ := flatten(candidate, test)
+			report.Add(test, kind, compareSeries(test, values, candidateValues, policy, maxDeviations))
+		}
+	}
+
+	tests := []string{}
+	for test := range candidate.Logs {
+		tests = append(tests, test)
+	}
+	compareKind(LogsKind, tests, func(b *BuildSummaries, test string) (seriesValues, bool) {
+		data, ok := b.Logs[test]
+		return flattenLogs(data), ok
+	})
+
+	tests = []string{}
+	for test := range candidate.Resources {
+		tests = append(tests, test)
+	}
+	compareKind(ResourcesKind, tests, func(b *BuildSummaries, test string) (seriesValues, bool) {
+		data, ok := b.Resources[test]
+		return flattenResources(data), ok
+	})
+
+	tests = []string{}
+	for test := range candidate.Metrics {
+		tests = append(tests, test)
+	}
+	compareKind(MetricsKind, tests, func(b *BuildSummaries, test string) (seriesValues, bool) {
+		data, ok := b.Metrics[test]
+		return flattenMetrics(data), ok
+	})
+
+	return report
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/test/e2e"
+)
+
+func TestMeanAndStddev(t *testing.T) {
+	testCases := []struct {
+		values []float64
+		mean   float64
+		stddev float64
+	}{
+		{values: []float64{5}, mean: 5, stddev: 0},
+		{values: []float64{2, 4, 4, 4, 5, 5, 7, 9}, mean: 5, stddev: math.Sqrt(32.0 / 7)},
+		{values: []float64{10, 10, 10}, mean: 10, stddev: 0},
+	}
+
+	for _, tc := range testCases {
+		mean, stddev := meanAndStddev(tc.values)
+		if mean != tc.mean || math.Abs(stddev-tc.stddev) > 1e-9 {
+			t.Errorf("%v: expected %v, %v but got %v, %v", tc.values, tc.mean, tc.stddev, mean, stddev)
+		}
+	}
+}
+
+func TestIsOutsideBaseline(t *testing.T) {
+	both := Threshold{AllowedVariancePercent: float64Ptr(10), Direction: DirectionBoth, Floor: float64Ptr(1)}
+	increase := Threshold{AllowedVariancePercent: float64Ptr(10), Direction: DirectionIncrease, Floor: float64Ptr(1)}
+	noisy := []float64{100, 200, 300}
+	stable := []float64{100, 101, 99}
+
+	testCases := []struct {
+		desc      string
+		baseline  []float64
+		value     float64
+		threshold Threshold
+		outside   bool
+	}{
+		{desc: "within the noise", baseline: noisy, value: 350, threshold: both, outside: false},
+		{desc: "outside the noise", baseline: noisy, value: 600, threshold: both, outside: true},
+		{desc: "stable increase", baseline: stable, value: 120, threshold: both, outside: true},
+		{desc: "stable decrease", baseline: stable, value: 80, threshold: both, outside: true},
+		{desc: "decrease with increase direction", baseline: stable, value: 80, threshold: increase, outside: false},
+		{desc: "within the allowed variance", baseline: stable, value: 105, threshold: both, outside: false},
+		{desc: "below the floor", baseline: []float64{0, 0}, value: 1, threshold: both, outside: false},
+	}
+
+	for _, tc := range testCases {
+		if _, _, outside := isOutsideBaseline(tc.baseline, tc.value, tc.threshold, 3); outside != tc.outside {
+			t.Errorf("%v: expected %v but got %v", tc.desc, tc.outside, outside)
+		}
+	}
+}
+
+func newResourceBuild(build int, test string, etcdCPU, proxyCPU float64) *BuildSummaries {
+	return &BuildSummaries{
+		Build: build,
+		Resources: map[string]*e2e.ResourceUsageSummary{
+			test: {
+				"90": []e2e.SingleContainerSummary{
+					{Name: "kube-dns-a/etcd", Cpu: etcdCPU, Mem: 100000000},
+					{Name: "kube-proxy-a/kube-proxy", Cpu: proxyCPU, Mem: 100000000},
+				},
+			},
+		},
+	}
+}
+
+func TestCompareWithBaseline(t *testing.T) {
+	baseline := []*BuildSummaries{
+		newResourceBuild(10, "density", 0.2, 0.2),
+		newResourceBuild(11, "density", 0.4, 0.21),
+		newResourceBuild(12, "density", 0.3, 0.19),
+	}
+	// etcd is within the noise of the baseline, kube-proxy is not
+	candidate := newResourceBuild(13, "density", 0.45, 0.4)
+	candidate.Resources["load"] = candidate.Resources["density"]
+
+	report := CompareWithBaseline(baseline, candidate, DefaultPolicy(), 3)
+	if !reflect.DeepEqual(report.BaselineBuilds, []int{10, 11, 12}) {
+		t.Errorf("Expected baseline builds 10, 11, 12 but got %v", report.BaselineBuilds)
+	}
+	if report.RightBuild != 13 {
+		t.Errorf("Expected build 13 but got %v", report.RightBuild)
+	}
+	if len(report.Checks) != 2 {
+		t.Fatalf("Expected 2 checks but got %+v", report.Checks)
+	}
+
+	density := report.Checks[0]
+	if density.Test != "density" || density.Missing || len(density.Violations) != 2 {
+		t.Fatalf("Expected min and max cpu violations for kube-proxy but got %+v", density)
+	}
+	for _, v := range density.Violations {
+		if v.Name != "kube-proxy" || v.Component != "cpu" || v.Right != 0.4 || math.Abs(v.Left-0.2) > 1e-9 || v.Stddev == nil {
+			t.Errorf("Unexpected violation %v", v)
+		}
+	}
+
+	if load := report.Checks[1]; load.Test != "load" || !load.Missing {
+		t.Errorf("Expected missing baseline for test load but got %+v", load)
+	}
+}
@@ -18,7 +18,6 @@ package src
 
 import (
 	"fmt"
-	"math"
 	"os"
 	"sort"
 	"text/tabwriter"
@@ -53,6 +52,8 @@ func (a logsDataArray) Less(i, j int) bool { return a[i].generationRate < a[j].g
 // ViolatingLogGenerationPair stores a pair of results which proves that the difference is too big
 type ViolatingLogGenerationPair struct {
 	left, right logsDataArray
+	// allowedVariance used to compare left and right
+	allowedVariance float64
 }
 
 // ViolatingLogGenerationData stores offending pairs keyed by the log file identified by the path.
@@ -88,12 +89,11 @@ func (d *ViolatingLogGenerationData) PrintToStdout(leftBuild, rightBuild int, en
 	}
 	printBuildNumber(rightBuild, writer, enableOutputColoring)
 	fmt.Fprint(writer, "\n")
-	allowedVariance := float64(100+LogsGenerationVarianceAllowedPercent) / float64(100)
 	for k, v := range *d {
 		fmt.Fprintf(writer, "%v\t", k)
-		writeViolatingLogsData(v.left, v.right, allowedVariance, enableOutputColoring, writer)
+		writeViolatingLogsData(v.left, v.right, v.allowedVariance, enableOutputColoring, writer)
 		fmt.Fprint(writer, "\t")
-		writeViolatingLogsData(v.right, v.left, allowedVariance, enableOutputColoring, writer)
+		writeViolatingLogsData(v.right, v.left, v.allowedVariance, enableOutputColoring, writer)
 		fmt.Fprint(writer, "\n")
 	}
 	writer.Flush()
@@ -112,22 +112,21 @@ func computeLogsAggregates(data *e2e.LogsSizeDataSummary) map[string]logsDataArr
 	return result
 }
 
-func isLogGenerationSimilarEnough(left logsDataArray, right logsDataArray, allowedVariance float64) bool {
-	leftMin := math.Max(left[0].generationRate, minLogGeneration)
-	leftMax := math.Max(left[len(left)-1].generationRate, minLogGeneration)
-	rightMin := math.Max(right[0].generationRate, minLogGeneration)
-	rightMax := math.Max(right[len(right)-1].generationRate, minLogGeneration)
-
-	return leq(leftMin, rightMin*allowedVariance) &&
-		leq(rightMin, leftMin*allowedVariance) &&
-		leq(leftMax, rightMax*allowedVariance) &&
-		leq(rightMax, leftMax*allowedVariance)
+func isLogGenerationSimilarEnough(left logsDataArray, right logsDataArray, threshold Threshold) bool {
+	allowedVariance := threshold.allowedVariance()
+	return threshold.similarEnough(left[0].generationRate, right[0].generationRate, allowedVariance) &&
+		threshold.similarEnough(left[len(left)-1].generationRate, right[len(right)-1].generationRate, allowedVariance)
 }
 
 // CompareLogGenerationSpeed given two summaries compares the data in them and returns set of
 // offending values. Precise semantics is that for each file (e.g. /var/log/kubelet.log) we check
 // if minimal and maximal values are roughly the same.
 func CompareLogGenerationSpeed(left *e2e.LogsSizeDataSummary, right *e2e.LogsSizeDataSummary) ViolatingLogGenerationData {
+	return CompareLogGenerationSpeedWithPolicy(left, right, DefaultPolicy())
+}
+
+// CompareLogGenerationSpeedWithPolicy is CompareLogGenerationSpeed using the allowed variance defined in the policy.
+func CompareLogGenerationSpeedWithPolicy(left *e2e.LogsSizeDataSummary, right *e2e.LogsSizeDataSummary, policy *Policy) ViolatingLogGenerationData {
 	result := make(ViolatingLogGenerationData)
 	if left == nil || right == nil {
 		glog.Warningf("At least one of received data is nil:\nleft: %p\nright:%p", left, right)
@@ -145,10 +144,12 @@ func CompareLogGenerationSpeed(left *e2e.LogsSizeDataSummary, right *e2e.LogsSiz
 			glog.V(4).Infof("Missing results for file %v on right-hand side.", path)
 			continue
 		}
-		if !isLogGenerationSimilarEnough(leftAggregates[path], rightAggregates[path], float64(100+LogsGenerationVarianceAllowedPercent)/float64(100)) {
+		threshold := policy.LogThreshold(path)
+		if !isLogGenerationSimilarEnough(leftAggregates[path], rightAggregates[path], threshold) {
 			result[path] = ViolatingLogGenerationPair{
-				left:  leftAggregates[path],
-				right: rightAggregates[path],
+				left:            leftAggregates[path],
+				right:           rightAggregates[path],
+				allowedVariance: threshold.allowedVariance(),
 			}
 		}
 	}
@@ -259,6 +259,7 @@ func TestLogCompareFailure(t *testing.T) {
 					generationRate: 2193,
 				},
 			},
+			allowedVariance: 1.5,
 		},
 	}
 
@@ -61,6 +61,8 @@ type ViolatingMetric struct {
 	labels      string
 	component   string
 	left, right float64
+	// allowedVariance used to compare left and right
+	allowedVariance float64
 }
 
 // ViolatingMetricsArr stores offending metrics keyed by the metric name.
@@ -69,7 +71,6 @@ type ViolatingMetricsArr map[string][]ViolatingMetric
 // PrintToStdout prints offending data to the Stdout in a human readable format.
 func (d *ViolatingMetricsArr) PrintToStdout(leftBuild, rightBuild int, enableOutputColoring bool) {
 	writer := tabwriter.NewWriter(os.Stdout, 1, 1, 1, ' ', 0)
-	allowedVariance := float64(100+MetricsVarianceAllowedPercent) / float64(100)
 	for metric, arr := range *d {
 		if enableOutputColoring {
 			ChangeColor(ct.Green, writer)
@@ -85,9 +86,9 @@ func (d *ViolatingMetricsArr) PrintToStdout(leftBuild, rightBuild int, enableOut
 		fmt.Fprint(writer, "\n")
 		for _, data := range arr {
 			fmt.Fprintf(writer, "%v\t%v\t", data.labels, data.component)
-			changeColorFloat64AndWrite(data.left, data.right, allowedVariance, enableOutputColoring, writer)
+			changeColorFloat64AndWrite(data.left, data.right, data.allowedVariance, enableOutputColoring, writer)
 			fmt.Fprint(writer, "\t")
-			changeColorFloat64AndWrite(data.right, data.left, allowedVariance, enableOutputColoring, writer)
+			changeColorFloat64AndWrite(data.right, data.left, data.allowedVariance, enableOutputColoring, writer)
 			fmt.Fprint(writer, "\t\n")
 		}
 		fmt.Fprint(writer, "\n")
@@ -153,37 +154,34 @@ func computeKubeletAggregates(data map[string]metrics.KubeletMetrics) *aggregate
 	return &result
 }
 
-func isSimpleMetricSimilarEnough(left float64, right float64, allowedVariance float64) bool {
-	// To avoid problems with 0 we assume all values are at least 1
-	left = math.Max(left, 1)
-	right = math.Max(right, 1)
-	// For very small values (1, 2) we always allow double
-	if left < 3 || right < 3 {
+// smallMetricsAllowedVariance returns the allowed variance for a pair of values. For very small
+// values (1, 2) we always allow double.
+func smallMetricsAllowedVariance(left, right float64, threshold Threshold) float64 {
+	allowedVariance := threshold.allowedVariance()
+	if math.Max(left, threshold.floor()) < 3 || math.Max(right, threshold.floor()) < 3 {
 		allowedVariance = math.Max(allowedVariance, 2)
 	}
-	return leq(left, right*allowedVariance) && leq(right, left*allowedVariance)
+	return allowedVariance
 }
 
-// Assumes that left and right are sorted
-func isKubeletMetricSimilarEnough(left kubeletSampleArr, right kubeletSampleArr, allowedVariance float64) bool {
-	// To avoid problems with 0 we assume all values are at least 1
-	leftMin := math.Max(left[0].value, 1)
-	leftMax := math.Max(left[len(left)-1].value, 1)
-	rightMin := math.Max(right[0].value, 1)
-	rightMax := math.Max(right[len(right)-1].value, 1)
+// To avoid problems with 0 the threshold should have a floor (1 by default)
+func isSimpleMetricSimilarEnough(left float64, right float64, threshold Threshold) bool {
+	return threshold.similarEnough(left, right, smallMetricsAllowedVariance(left, right, threshold))
+}
 
-	// For very small values (1, 2) we always allow double
-	if leftMin < 3 || rightMin < 3 {
-		allowedVariance = math.Max(allowedVariance, 2)
-	}
+// Assumes that left and right are sorted
+func isKubeletMetricSimilarEnough(left kubeletSampleArr, right kubeletSampleArr, threshold Threshold) bool {
+	leftMin := left[0].value
+	leftMax := left[len(left)-1].value
+	rightMin := right[0].value
+	rightMax := right[len(right)-1].value
 
-	return leq(leftMin, rightMin*allowedVariance) &&
-		leq(rightMin, leftMin*allowedVariance) &&
-		leq(leftMax, rightMax*allowedVariance) &&
-		leq(rightMax, leftMax*allowedVariance)
+	allowedVariance := smallMetricsAllowedVariance(leftMin, rightMin, threshold)
+	return threshold.similarEnough(leftMin, rightMin, allowedVariance) &&
+		threshold.similarEnough(leftMax, rightMax, allowedVariance)
 }
 
-func compareSamples(left *model.Samples, right *model.Samples, component string) ViolatingMetricsArr {
+func compareSamples(left *model.Samples, right *model.Samples, component string, policy *Policy) ViolatingMetricsArr {
 	leftAggregate := flattenSamples(left)
 	rightAggregate := flattenSamples(right)
 	violatingMetrics := make(ViolatingMetricsArr)
@@ -197,12 +195,14 @@ func compareSamples(left *model.Samples, right *model.Samples, component string)
 					glog.V(4).Infof("Missing group \"%v\" for metric %v on right-hand side.", bucket, metric)
 					continue
 				} else {
-					if !isSimpleMetricSimilarEnough(lv, rv, float64(100+MetricsVarianceAllowedPercent)/float64(100)) {
+					threshold := policy.MetricThreshold(metric, component)
+					if !isSimpleMetricSimilarEnough(lv, rv, threshold) {
 						violatingMetrics[metric] = append(violatingMetrics[metric], ViolatingMetric{
-							labels:    bucket,
-							component: component,
-							left:      lv,
-							right:     rv,
+							labels:          bucket,
+							component:       component,
+							left:            lv,
+							right:           rv,
+							allowedVariance: threshold.allowedVariance(),
 						})
 					}
 				}
@@ -216,7 +216,7 @@ func compareSamples(left *model.Samples, right *model.Samples, component string)
 	return violatingMetrics
 }
 
-func compareKubeletMetrics(left map[string]metrics.KubeletMetrics, right map[string]metrics.KubeletMetrics, violating *ViolatingMetricsArr) {
+func compareKubeletMetrics(left map[string]metrics.KubeletMetrics, right map[string]metrics.KubeletMetrics, violating *ViolatingMetricsArr, policy *Policy) {
 	leftAggregate := computeKubeletAggregates(left)
 	rightAggregate := computeKubeletAggregates(right)
 	for metric, la := range *leftAggregate {
@@ -231,19 +231,22 @@ func compareKubeletMetrics(left map[string]metrics.KubeletMetrics, right map[str
 				} else {
 					sort.Sort(lv)
 					sort.Sort(rv)
-					if !isKubeletMetricSimilarEnough(lv, rv, float64(100+MetricsVarianceAllowedPercent)/float64(100)) {
+					threshold := policy.MetricThreshold(metric, KubeletComponent)
+					if !isKubeletMetricSimilarEnough(lv, rv, threshold) {
 						(*violating)[metric] = append((*violating)[metric],
 							ViolatingMetric{
-								labels:    bucket,
-								component: fmt.Sprintf("%v#%v#MIN", lv[0].node, rv[0].node),
-								left:      lv[0].value,
-								right:     rv[0].value,
+								labels:          bucket,
+								component:       fmt.Sprintf("%v#%v#MIN", lv[0].node, rv[0].node),
+								left:            lv[0].value,
+								right:           rv[0].value,
+								allowedVariance: threshold.allowedVariance(),
 							},
 							ViolatingMetric{
-								labels:    bucket,
-								component: fmt.Sprintf("%v#%v#MAX", lv[len(lv)-1].node, rv[len(rv)-1].node),
-								left:      lv[len(lv)-1].value,
-								right:     rv[len(rv)-1].value,
+								labels:          bucket,
+								component:       fmt.Sprintf("%v#%v#MAX", lv[len(lv)-1].node, rv[len(rv)-1].node),
+								left:            lv[len(lv)-1].value,
+								right:           rv[len(rv)-1].value,
+								allowedVariance: threshold.allowedVariance(),
 							})
 					}
 				}
@@ -252,7 +255,7 @@ func compareKubeletMetrics(left map[string]metrics.KubeletMetrics, right map[str
 	}
 }
 
-func compareSimpleMetrics(left *metrics.Metrics, right *metrics.Metrics, violating *ViolatingMetricsArr, component string) {
+func compareSimpleMetrics(left *metrics.Metrics, right *metrics.Metrics, violating *ViolatingMetricsArr, component string, policy *Policy) {
 	for k := range *left {
 		if _, ok := (*right)[k]; !ok {
 			glog.V(4).Infof("Missing metric %v on right-hand side.", k)
@@ -260,7 +263,7 @@ func compareSimpleMetrics(left *metrics.Metrics, right *metrics.Metrics, violati
 		}
 		leftCopy := (*left)[k]
 		rightCopy := (*right)[k]
-		for k, v := range compareSamples(&leftCopy, &rightCopy, component) {
+		for k, v := range compareSamples(&leftCopy, &rightCopy, component, policy) {
 			(*violating)[k] = append((*violating)[k], v...)
 		}
 	}
@@ -270,14 +273,19 @@ func compareSimpleMetrics(left *metrics.Metrics, right *metrics.Metrics, violati
 // offending values. Precise semantics is that for each metric (identified as a name and set of labels) we check
 // if values are roughly the same. For kubelet metrics we check minimal and maximal values over all Kubelets.
 func CompareMetrics(left *e2e.MetricsForE2E, right *e2e.MetricsForE2E) ViolatingMetricsArr {
+	return CompareMetricsWithPolicy(left, right, DefaultPolicy())
+}
+
+// CompareMetricsWithPolicy is CompareMetrics using the allowed variance defined in the policy.
+func CompareMetricsWithPolicy(left *e2e.MetricsForE2E, right *e2e.MetricsForE2E, policy *Policy) ViolatingMetricsArr {
 	violatingMetrics := make(ViolatingMetricsArr)
 	if left == nil || right == nil {
 		glog.Warningf("At least one of received data is nil:\nleft: %p\nright:%p", left, right)
 		return ViolatingMetricsArr{}
 	}
-	compareSimpleMetrics((*metrics.Metrics)(&left.ApiServerMetrics), (*metrics.Metrics)(&right.ApiServerMetrics), &violatingMetrics, "ApiServer")
-	compareSimpleMetrics((*metrics.Metrics)(&left.ControllerManagerMetrics), (*metrics.Metrics)(&right.ControllerManagerMetrics), &violatingMetrics, "ControllerManager")
-	compareSimpleMetrics((*metrics.Metrics)(&left.SchedulerMetrics), (*metrics.Metrics)(&right.SchedulerMetrics), &violatingMetrics, "Scheduler")
-	compareKubeletMetrics(left.KubeletMetrics, right.KubeletMetrics, &violatingMetrics)
+	compareSimpleMetrics((*metrics.Metrics)(&left.ApiServerMetrics), (*metrics.Metrics)(&right.ApiServerMetrics), &violatingMetrics, "ApiServer", policy)
+	compareSimpleMetrics((*metrics.Metrics)(&left.ControllerManagerMetrics), (*metrics.Metrics)(&right.ControllerManagerMetrics), &violatingMetrics, "ControllerManager", policy)
+	compareSimpleMetrics((*metrics.Metrics)(&left.SchedulerMetrics), (*metrics.Metrics)(&right.SchedulerMetrics), &violatingMetrics, "Scheduler", policy)
+	compareKubeletMetrics(left.KubeletMetrics, right.KubeletMetrics, &violatingMetrics, policy)
 	return violatingMetrics
 }
@@ -925,30 +925,34 @@ func TestMetricCompareFailure(t *testing.T) {
 	expected := ViolatingMetricsArr{
 		"apiserver_request_count": []ViolatingMetric{
 			{
-				labels:    "client=e2e.test, code=200, resource=events, verb=LIST",
-				component: "ApiServer",
-				left:      5,
-				right:     3,
+				labels:          "client=e2e.test, code=200, resource=events, verb=LIST",
+				component:       "ApiServer",
+				left:            5,
+				right:           3,
+				allowedVariance: 1.5,
 			},
 			{
-				labels:    "client=e2e.test, code=200, resource=events, verb=WATCHLIST",
-				component: "ApiServer",
-				left:      1,
-				right:     10,
+				labels:          "client=e2e.test, code=200, resource=events, verb=WATCHLIST",
+				component:       "ApiServer",
+				left:            1,
+				right:           10,
+				allowedVariance: 1.5,
 			},
 		},
 		"go_goroutines": []ViolatingMetric{
 			{
-				labels:    "",
-				component: "e2e-test-master#e2e-test-master#MIN",
-				left:      119,
-				right:     119,
+				labels:          "",
+				component:       "e2e-test-master#e2e-test-master#MIN",
+				left:            119,
+				right:           119,
+				allowedVariance: 1.5,
 			},
 			{
-				labels:    "",
-				component: "e2e-test-minion#e2e-test-minion#MAX",
-				left:      219,
-				right:     519,
+				labels:          "",
+				component:       "e2e-test-minion#e2e-test-minion#MAX",
+				left:            219,
+				right:           519,
+				allowedVariance: 1.5,
 			},
 		},
 	}
@@ -18,7 +18,6 @@ package src
 
 import (
 	"fmt"
-	"math"
 	"os"
 	"sort"
 	"strings"
@@ -55,6 +54,9 @@ type ViolatingResourceUsageDataPair struct {
 	rightCPUData []float64
 	leftMemData  []int64
 	rightMemData []int64
+	// allowed variance used to compare CPU and memory usage
+	cpuAllowedVariance float64
+	memAllowedVariance float64
 }
 
 // ViolatingResourceUsageData stores offending pairs keyed by the log file
@@ -98,7 +100,6 @@ func (d *ViolatingResourceUsageData) PrintToStdout(leftBuild, rightBuild int, en
 	}
 	printBuildNumber(rightBuild, writer, enableOutputColoring)
 	fmt.Fprint(writer, "\n")
-	allowedVariance := float64(100+ResourceUsageVarianceAllowedPercent) / float64(100)
 	for k, v := range *d {
 		fmt.Fprintf(writer, "%v\t%v\t", v.percentile, k)
 
@@ -111,13 +112,13 @@ func (d *ViolatingResourceUsageData) PrintToStdout(leftBuild, rightBuild int, en
 		rightMemMin := v.rightMemData[0]
 		rightMemMax := v.rightMemData[len(v.rightMemData)-1]
 
-		writeViolatingResourceData(leftCPUMin, leftCPUMax, rightCPUMin, rightCPUMax, allowedVariance, enableOutputColoring, writer)
+		writeViolatingResourceData(leftCPUMin, leftCPUMax, rightCPUMin, rightCPUMax, v.cpuAllowedVariance, enableOutputColoring, writer)
 		fmt.Fprint(writer, "\t")
-		writeViolatingResourceData(float64(leftMemMin), float64(leftMemMax), float64(rightMemMin), float64(rightMemMax), allowedVariance, enableOutputColoring, writer)
+		writeViolatingResourceData(float64(leftMemMin), float64(leftMemMax), float64(rightMemMin), float64(rightMemMax), v.memAllowedVariance, enableOutputColoring, writer)
 		fmt.Fprint(writer, "\t")
-		writeViolatingResourceData(rightCPUMin, rightCPUMax, leftCPUMin, leftCPUMax, allowedVariance, enableOutputColoring, writer)
+		writeViolatingResourceData(rightCPUMin, rightCPUMax, leftCPUMin, leftCPUMax, v.cpuAllowedVariance, enableOutputColoring, writer)
 		fmt.Fprint(writer, "\t")
-		writeViolatingResourceData(float64(rightMemMin), float64(rightMemMax), float64(leftMemMin), float64(leftMemMax), allowedVariance, enableOutputColoring, writer)
+		writeViolatingResourceData(float64(rightMemMin), float64(rightMemMax), float64(leftMemMin), float64(leftMemMax), v.memAllowedVariance, enableOutputColoring, writer)
 		fmt.Fprint(writer, "\n")
 	}
 	writer.Flush()
@@ -147,9 +148,9 @@ func getContainerKind(containerName string) string {
 	return containerName[strings.LastIndex(containerName, "/")+1:]
 }
 
-// A simple comparison checking if minimum and maximums in both datasets are within allowedVariance
+// A simple comparison checking if minimum and maximums in both datasets are within the allowed variance
 // If this function changes, PrintToStdout should be updated accordingly.
-func isResourceUsageSimilarEnough(left, right percentileUsageData, allowedVariance float64) bool {
+func isResourceUsageSimilarEnough(left, right percentileUsageData, threshold ResourceThreshold) bool {
 	if len(left.cpuData) == 0 || len(left.memData) == 0 || len(right.cpuData) == 0 || len(right.memData) == 0 {
 		glog.V(4).Infof("Length of at least one data vector is zero. Returning false for the lack of data.")
 		return false
@@ -160,23 +161,12 @@ func isResourceUsageSimilarEnough(left, right percentileUsageData, allowedVarian
 	sort.Sort(int64arr(left.memData))
 	sort.Sort(int64arr(right.memData))
 
-	leftCPUMin := math.Max(left.cpuData[0], minCPU)
-	leftCPUMax := math.Max(left.cpuData[len(left.cpuData)-1], minCPU)
-	leftMemMin := max(left.memData[0], minMem)
-	leftMemMax := max(left.memData[len(left.memData)-1], minMem)
-	rightCPUMin := math.Max(right.cpuData[0], minCPU)
-	rightCPUMax := math.Max(right.cpuData[len(right.cpuData)-1], minCPU)
-	rightMemMin := max(right.memData[0], minMem)
-	rightMemMax := max(right.memData[len(right.memData)-1], minMem)
-
-	return leq(leftCPUMin, allowedVariance*rightCPUMin) &&
-		leq(rightCPUMin, allowedVariance*leftCPUMin) &&
-		leq(leftCPUMax, allowedVariance*rightCPUMax) &&
-		leq(rightCPUMax, allowedVariance*leftCPUMax) &&
-		leq(float64(leftMemMin), allowedVariance*float64(rightMemMin)) &&
-		leq(float64(rightMemMin), allowedVariance*float64(leftMemMin)) &&
-		leq(float64(leftMemMax), allowedVariance*float64(rightMemMax)) &&
-		leq(float64(rightMemMax), allowedVariance*float64(leftMemMax))
+	cpu := threshold.CPU
+	mem := threshold.Memory
+	return cpu.similarEnough(left.cpuData[0], right.cpuData[0], cpu.allowedVariance()) &&
+		cpu.similarEnough(left.cpuData[len(left.cpuData)-1], right.cpuData[len(right.cpuData)-1], cpu.allowedVariance()) &&
+		mem.similarEnough(float64(left.memData[0]), float64(right.memData[0]), mem.allowedVariance()) &&
+		mem.similarEnough(float64(left.memData[len(left.memData)-1]), float64(right.memData[len(right.memData)-1]), mem.allowedVariance())
 }
 
 // Pivoting the data from percentile -> container to container_kind -> percentile
@@ -206,6 +196,11 @@ func computeResourceAggregates(data *e2e.ResourceUsageSummary) map[string][]perc
 // offending values. Precise semantics is that for each container type, identified by the container
 // name we check if minimal and maximal values for both CPU and memory usage are roughly the same.
 func CompareResourceUsages(left *e2e.ResourceUsageSummary, right *e2e.ResourceUsageSummary) ViolatingResourceUsageData {
+	return CompareResourceUsagesWithPolicy(left, right, DefaultPolicy())
+}
+
+// CompareResourceUsagesWithPolicy is CompareResourceUsages using the allowed variance defined in the policy.
+func CompareResourceUsagesWithPolicy(left *e2e.ResourceUsageSummary, right *e2e.ResourceUsageSummary, policy *Policy) ViolatingResourceUsageData {
 	result := make(ViolatingResourceUsageData)
 	if left == nil || right == nil {
 		glog.Warningf("At least one of received data is nil:\nleft: %p\nright:%p", left, right)
@@ -230,13 +225,16 @@ func CompareResourceUsages(left *e2e.ResourceUsageSummary, right *e2e.ResourceUs
 				glog.V(4).Infof("Right-hand data for %v missing percentile: %v, skipping", container, leftAggregates[container][i].percentile)
 				continue
 			}
-			if !isResourceUsageSimilarEnough(leftAggregates[container][i], rightAggregates[container][j], float64(100+ResourceUsageVarianceAllowedPercent)/float64(100)) {
+			threshold := policy.ResourceThreshold(container)
+			if !isResourceUsageSimilarEnough(leftAggregates[container][i], rightAggregates[container][j], threshold) {
 				result[container] = ViolatingResourceUsageDataPair{
-					percentile:   leftAggregates[container][i].percentile,
-					leftCPUData:  leftAggregates[container][i].cpuData,
-					rightCPUData: rightAggregates[container][i].cpuData,
-					leftMemData:  leftAggregates[container][i].memData,
-					rightMemData: rightAggregates[container][i].memData,
+					percentile:         leftAggregates[container][i].percentile,
+					leftCPUData:        leftAggregates[container][i].cpuData,
+					rightCPUData:       rightAggregates[container][i].cpuData,
+					leftMemData:        leftAggregates[container][i].memData,
+					rightMemData:       rightAggregates[container][i].memData,
+					cpuAllowedVariance: threshold.CPU.allowedVariance(),
+					memAllowedVariance: threshold.Memory.allowedVariance(),
 				}
 			}
 		}
@@ -143,11 +143,13 @@ func TestResourceCompareFailure(t *testing.T) {
 	}
 	expectedViolating := ViolatingResourceUsageData{
 		"etcd": {
-			percentile:   "90",
-			leftCPUData:  []float64{0.18},
-			leftMemData:  []int64{18530000},
-			rightCPUData: []float64{0.008},
-			rightMemData: []int64{17050000},
+			percentile:         "90",
+			leftCPUData:        []float64{0.18},
+			leftMemData:        []int64{18530000},
+			rightCPUData:       []float64{0.008},
+			rightMemData:       []int64{17050000},
+			cpuAllowedVariance: 1.5,
+			memAllowedVariance: 1.5,
 		},
 	}
 
@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonreader reads a JSON document embedded in a log line by line, counting braces to
+// know when it is complete.
+package jsonreader
+
+import (
+	"bytes"
+)
+
+// Reader accumulates the lines of a JSON document until its braces and brackets are balanced.
+// Braces in strings are not counted.
+type Reader struct {
+	buff  bytes.Buffer
+	depth int
+	// started is true once the first brace was read
+	started  bool
+	inString bool
+	escaped  bool
+}
+
+// Add appends a line of the document and returns true when the document is complete.
+func (r *Reader) Add(line string) bool {
+	r.buff.WriteString(line)
+	r.buff.WriteString("\n")
+	for _, c := range line {
+		switch {
+		case r.escaped:
+			r.escaped = false
+		case r.inString && c == '\\':
+			r.escaped = true
+		case c == '"':
+			r.inString = !r.inString
+		case r.inString:
+		case c == '{' || c == '[':
+			r.depth++
+			r.started = true
+		case c == '}' || c == ']':
+			r.depth--
+		}
+	}
+	return r.Complete()
+}
+
+// Started returns true once the first brace or bracket of the document was read.
+func (r *Reader) Started() bool {
+	return r.started
+}
+
+// Complete returns true when the braces of the document are balanced.
+func (r *Reader) Complete() bool {
+	return r.started && r.depth <= 0
+}
+
+// Bytes returns the lines read so far.
+func (r *Reader) Bytes() []byte {
+	return r.buff.Bytes()
+}
+
+// String returns the lines read so far as a string.
+func (r *Reader) String() string {
+	return r.buff.String()
+}
@@ -20,29 +20,145 @@ import (
 	"bufio"
 	goflag "flag"
 	"fmt"
-	"net/http"
 	"os"
+	"sort"
 
 	"k8s.io/contrib/compare/src"
+	"k8s.io/contrib/mungegithub/mungers/jenkins"
+	"k8s.io/kubernetes/test/e2e"
 
 	"github.com/daviddengcn/go-colortext"
 	"github.com/spf13/pflag"
 )
 
 const (
-	urlPrefix = "https://storage.googleapis.com/kubernetes-jenkins/logs/kubernetes-e2e-gce-scalability/"
-	urlSuffix = "/build-log.txt"
+	defaultURLTemplate = "https://storage.googleapis.com/kubernetes-jenkins/logs/kubernetes-e2e-gce-scalability/" + src.BuildPlaceholder + "/build-log.txt"
+
+	textOutputFormat  = "text"
+	jsonOutputFormat  = "json"
+	junitOutputFormat = "junit"
+
+	// violationsExitCode is returned when the builds differ more than allowed
+	violationsExitCode = 3
+
+	urlLogSource     = "url"
+	fileLogSource    = "file"
+	jenkinsLogSource = "jenkins"
 )
 
 var (
 	leftBuildNumber, rightBuildNumber int
 	enableOutputColoring              bool
+	logSource                         string
+	logURLTemplate                    string
+	logFileTemplate                   string
+	jenkinsHost                       string
+	jenkinsJob                        string
+	output                            string
+	policyFile                        string
+	baselineBuilds                    []int
+	baselineWindow                    int
+	maxDeviations                     float64
 )
 
 func registerFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&leftBuildNumber, "left-build-number", 0, "Id of the build to serve as a left hand side of comparison.")
 	fs.IntVar(&rightBuildNumber, "right-build-number", 0, "Id of the build to serve as a right hand side of comparison.")
 	fs.BoolVar(&enableOutputColoring, "enable-output-coloring", true, "If set to true tool will print offending values in color")
+	fs.StringVar(&output, "output", textOutputFormat, "Output format: text, json or junit. The tool exits with code 3 if any value differs more than allowed.")
+	fs.IntSliceVar(&baselineBuilds, "baseline-builds", []int{}, "Builds used as baseline for the right build instead of the left build.")
+	fs.IntVar(&baselineWindow, "baseline-window", 0, "If set, the N builds before the right build are used as baseline instead of the left build.")
+	fs.Float64Var(&maxDeviations, "max-deviations", 3, "When comparing against baseline builds, values are flagged only if they are more than this number of standard deviations away from the baseline mean.")
+	fs.StringVar(&policyFile, "policy-file", "", "YAML file with the allowed variance per metric, component, container kind or log file. If empty the default thresholds are used.")
+	fs.StringVar(&logSource, "log-source", urlLogSource, "Where build logs are read from: url, file or jenkins.")
+	fs.StringVar(&logURLTemplate, "log-url-template", defaultURLTemplate, "URL of the build log used by the url source. "+src.BuildPlaceholder+" is replaced by the build number.")
+	fs.StringVar(&logFileTemplate, "log-file-template", "", "Path of the build log used by the file source. "+src.BuildPlaceholder+" is replaced by the build number.")
+	fs.StringVar(&jenkinsHost, "jenkins-host", "", "Jenkins server used by the jenkins source, eg. http://jenkins.example.com:8080")
+	fs.StringVar(&jenkinsJob, "jenkins-job", "kubernetes-e2e-gce-scalability", "Jenkins job used by the jenkins source.")
+}
+
+// newLogSource returns the LogSource selected by the flags
+func newLogSource() (src.LogSource, error) {
+	switch logSource {
+	case urlLogSource:
+		if logURLTemplate == "" {
+			return nil, fmt.Errorf("--log-url-template is required by the %v source", urlLogSource)
+		}
+		return &src.URLSource{URLTemplate: logURLTemplate}, nil
+	case fileLogSource:
+		if logFileTemplate == "" {
+			return nil, fmt.Errorf("--log-file-template is required by the %v source", fileLogSource)
+		}
+		return &src.FileSource{PathTemplate: logFileTemplate}, nil
+	case jenkinsLogSource:
+		if jenkinsHost == "" || jenkinsJob == "" {
+			return nil, fmt.Errorf("--jenkins-host and --jenkins-job are required by the %v source", jenkinsLogSource)
+		}
+		return &src.JenkinsSource{Client: &jenkins.JenkinsClient{Host: jenkinsHost}, Job: jenkinsJob}, nil
+	}
+	return nil, fmt.Errorf("unknown log source %q, expected %v, %v or %v", logSource, urlLogSource, fileLogSource, jenkinsLogSource)
+}
+
+// processBuild reads the log of a build from the source and parses the summaries embedded in it
+func processBuild(source src.LogSource, buildNumber int) (*src.BuildSummaries, error) {
+	body, err := source.Open(buildNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	summaries := src.ExtractSummaries(scanner, buildNumber)
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading log of build %d: %v", buildNumber, err)
+	}
+	for _, e := range summaries.Errors {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", e)
+	}
+	return summaries, nil
+}
+
+// getBaselineBuilds returns the builds used as baseline: the ones in --baseline-builds or the
+// --baseline-window builds before the right build.
+func getBaselineBuilds() []int {
+	if len(baselineBuilds) != 0 {
+		return baselineBuilds
+	}
+	builds := []int{}
+	for build := rightBuildNumber - baselineWindow; build < rightBuildNumber; build++ {
+		if build > 0 {
+			builds = append(builds, build)
+		}
+	}
+	return builds
+}
+
+// compareWithBaseline compares the right build with the baseline builds. Baseline builds that
+// can't be read are skipped.
+func compareWithBaseline(source src.LogSource, policy *src.Policy) (*src.Report, error) {
+	baseline := []*src.BuildSummaries{}
+	for _, build := range getBaselineBuilds() {
+		summaries, err := processBuild(source, build)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping baseline build %v: %v\n", build, err)
+			continue
+		}
+		baseline = append(baseline, summaries)
+	}
+	if len(baseline) < 2 {
+		return nil, fmt.Errorf("need at least 2 baseline builds, got %v", len(baseline))
+	}
+
+	candidate, err := processBuild(source, rightBuildNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	report := src.CompareWithBaseline(baseline, candidate, policy, maxDeviations)
+	if output == textOutputFormat {
+		return report, report.WriteText(os.Stdout)
+	}
+	return report, nil
 }
 
 func main() {
@@ -50,94 +166,170 @@ func main() {
 	pflag.CommandLine.AddGoFlagSet(goflag.CommandLine)
 	pflag.Parse()
 
-	if leftBuildNumber == 0 || rightBuildNumber == 0 {
-		fmt.Fprintf(os.Stderr, "Need both left and right build numbers")
-		return
+	useBaseline := len(baselineBuilds) != 0 || baselineWindow != 0
+	if rightBuildNumber == 0 || (leftBuildNumber == 0 && !useBaseline) {
+		fmt.Fprintf(os.Stderr, "Need both left and right build numbers, or the right build number and baseline builds\n")
+		os.Exit(1)
+	}
+	if output != textOutputFormat && output != jsonOutputFormat && output != junitOutputFormat {
+		fmt.Fprintf(os.Stderr, "Unknown output format %q, expected %v, %v or %v\n", output, textOutputFormat, jsonOutputFormat, junitOutputFormat)
+		os.Exit(1)
+	}
+
+	policy := src.DefaultPolicy()
+	if policyFile != "" {
+		var err error
+		if policy, err = src.LoadPolicy(policyFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading policy %v: %v\n", policyFile, err)
+			os.Exit(1)
+		}
 	}
 
-	leftResp, err := http.Get(fmt.Sprintf("%v%v%v", urlPrefix, leftBuildNumber, urlSuffix))
+	source, err := newLogSource()
 	if err != nil {
-		panic(err)
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
 	}
-	leftBody := leftResp.Body
-	defer leftBody.Close()
-	leftBodyScanner := bufio.NewScanner(leftBody)
-	leftLogs, leftResources, leftMetrics := src.ProcessSingleTest(leftBodyScanner, leftBuildNumber)
 
-	rightResp, err := http.Get(fmt.Sprintf("%v%v%v", urlPrefix, rightBuildNumber, urlSuffix))
+	var report *src.Report
+	if useBaseline {
+		report, err = compareWithBaseline(source, policy)
+	} else {
+		report, err = compareBuilds(source, policy)
+	}
 	if err != nil {
-		panic(err)
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
 	}
-	rightBody := rightResp.Body
-	defer rightBody.Close()
-	rightBodyScanner := bufio.NewScanner(rightBody)
-	rightLogs, rightResources, rightMetrics := src.ProcessSingleTest(rightBodyScanner, rightBuildNumber)
+
+	switch output {
+	case jsonOutputFormat:
+		err = report.WriteJSON(os.Stdout)
+	case junitOutputFormat:
+		err = report.WriteJUnit(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing the report: %v\n", err)
+		os.Exit(1)
+	}
+
+	if report.HasViolations() {
+		os.Exit(violationsExitCode)
+	}
+}
+
+// compareBuilds compares the left and the right builds. In text mode the differences are
+// printed while comparing.
+func compareBuilds(source src.LogSource, policy *src.Policy) (*src.Report, error) {
+	left, err := processBuild(source, leftBuildNumber)
+	if err != nil {
+		return nil, err
+	}
+	right, err := processBuild(source, rightBuildNumber)
+	if err != nil {
+		return nil, err
+	}
+	leftLogs, leftResources, leftMetrics := left.Logs, left.Resources, left.Metrics
+	rightLogs, rightResources, rightMetrics := right.Logs, right.Resources, right.Metrics
+
+	report := src.NewReport(leftBuildNumber, rightBuildNumber)
+	report.AddSummaryErrors(left, right)
+	textOutput := output == textOutputFormat
 
 	if len(leftLogs) != 0 && len(rightLogs) != 0 {
-		for k := range leftLogs {
+		for _, k := range sortedKeys(leftLogs) {
 			if _, ok := rightLogs[k]; !ok {
-				fmt.Printf("Right logs missing for test %v\n", k)
+				report.AddMissing(k, src.LogsKind)
+				if textOutput {
+					fmt.Printf("Right logs missing for test %v\n", k)
+				}
 				continue
 			}
-			violatingLogs := src.CompareLogGenerationSpeed(leftLogs[k], rightLogs[k])
-			if len(violatingLogs) == 0 {
+			violatingLogs := src.CompareLogGenerationSpeedWithPolicy(leftLogs[k], rightLogs[k], policy)
+			report.Add(k, src.LogsKind, violatingLogs.Violations(k))
+			if len(violatingLogs) == 0 || !textOutput {
 				continue
 			}
-			if enableOutputColoring {
-				src.ChangeColor(ct.Cyan, os.Stdout)
-			}
-			fmt.Printf("Differences for test %v", k)
-			if enableOutputColoring {
-				src.ResetColor(os.Stdout)
-			}
-			fmt.Print("\n")
+			printTestHeader(k)
 			violatingLogs.PrintToStdout(leftBuildNumber, rightBuildNumber, enableOutputColoring)
 		}
 	}
-	fmt.Println("")
+	if textOutput {
+		fmt.Println("")
+	}
 
 	if len(leftResources) != 0 && len(rightResources) != 0 {
-		for k := range leftResources {
+		for _, k := range sortedKeys(leftResources) {
 			if _, ok := rightResources[k]; !ok {
-				fmt.Printf("Right resources missing for test %v\n", k)
+				report.AddMissing(k, src.ResourcesKind)
+				if textOutput {
+					fmt.Printf("Right resources missing for test %v\n", k)
+				}
 				continue
 			}
-			violatingResources := src.CompareResourceUsages(leftResources[k], rightResources[k])
-			if len(violatingResources) == 0 {
+			violatingResources := src.CompareResourceUsagesWithPolicy(leftResources[k], rightResources[k], policy)
+			report.Add(k, src.ResourcesKind, violatingResources.Violations(k))
+			if len(violatingResources) == 0 || !textOutput {
 				continue
 			}
-			if enableOutputColoring {
-				src.ChangeColor(ct.Cyan, os.Stdout)
-			}
-			fmt.Printf("Differences for test %v", k)
-			if enableOutputColoring {
-				src.ResetColor(os.Stdout)
-			}
-			fmt.Print("\n")
+			printTestHeader(k)
 			violatingResources.PrintToStdout(leftBuildNumber, rightBuildNumber, enableOutputColoring)
 		}
 	}
-	fmt.Println("")
+	if textOutput {
+		fmt.Println("")
+	}
 
 	if len(leftMetrics) != 0 && len(rightMetrics) != 0 {
-		for k := range rightMetrics {
+		for _, k := range sortedKeys(leftMetrics) {
 			if _, ok := rightMetrics[k]; !ok {
-				fmt.Printf("Right resources missing for test %v\n", k)
+				report.AddMissing(k, src.MetricsKind)
+				if textOutput {
+					fmt.Printf("Right metrics missing for test %v\n", k)
+				}
 				continue
 			}
-			violatingMetrics := src.CompareMetrics(leftMetrics[k], rightMetrics[k])
-			if len(violatingMetrics) == 0 {
+			violatingMetrics := src.CompareMetricsWithPolicy(leftMetrics[k], rightMetrics[k], policy)
+			report.Add(k, src.MetricsKind, violatingMetrics.Violations(k))
+			if len(violatingMetrics) == 0 || !textOutput {
 				continue
 			}
-			if enableOutputColoring {
-				src.ChangeColor(ct.Cyan, os.Stdout)
-			}
-			fmt.Printf("Differences for test %v", k)
-			if enableOutputColoring {
-				src.ResetColor(os.Stdout)
-			}
-			fmt.Print("\n")
+			printTestHeader(k)
 			violatingMetrics.PrintToStdout(leftBuildNumber, rightBuildNumber, enableOutputColoring)
 		}
 	}
+
+	return report, nil
+}
+
+func printTestHeader(test string) {
+	if enableOutputColoring {
+		src.ChangeColor(ct.Cyan, os.Stdout)
+	}
+	fmt.Printf("Differences for test %v", test)
+	if enableOutputColoring {
+		src.ResetColor(os.Stdout)
+	}
+	fmt.Print("\n")
+}
+
+// sortedKeys returns the test names of a map of summaries in order
+func sortedKeys(summaries interface{}) []string {
+	keys := []string{}
+	switch s := summaries.(type) {
+	case map[string]*e2e.LogsSizeDataSummary:
+		for k := range s {
+			keys = append(keys, k)
+		}
+	case map[string]*e2e.ResourceUsageSummary:
+		for k := range s {
+			keys = append(keys, k)
+		}
+	case map[string]*e2e.MetricsForE2E:
+		for k := range s {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
 }
@@ -0,0 +1,252 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DirectionBoth flags values that are too big or too small compared to the left-hand side.
+	DirectionBoth = "both"
+	// DirectionIncrease flags only values that are too big compared to the left-hand side.
+	DirectionIncrease = "increase"
+
+	// KubeletComponent is the component used to look up the threshold of kubelet metrics.
+	KubeletComponent = "Kubelet"
+)
+
+// Threshold defines when the difference between two values is too big. Fields that are not
+// set are taken from the more generic threshold (eg. the component or the default one).
+type Threshold struct {
+	// AllowedVariancePercent is how much bigger than the smaller value the greater one can be.
+	AllowedVariancePercent *float64 `yaml:"allowedVariancePercent,omitempty"`
+	// Direction is DirectionBoth or DirectionIncrease.
+	Direction string `yaml:"direction,omitempty"`
+	// Floor is the minimal value assumed for both sides, to avoid false negatives on small values.
+	Floor *float64 `yaml:"floor,omitempty"`
+}
+
+// ResourceThreshold contains the thresholds for CPU (in cores) and memory (in bytes) usage.
+type ResourceThreshold struct {
+	CPU    Threshold `yaml:"cpu"`
+	Memory Threshold `yaml:"memory"`
+}
+
+// MetricsPolicy contains the thresholds for the metrics of the components. The threshold of a
+// metric name takes precedence over the one of the component (ApiServer, ControllerManager,
+// Scheduler or Kubelet).
+type MetricsPolicy struct {
+	Default    Threshold            `yaml:"default"`
+	Components map[string]Threshold `yaml:"components,omitempty"`
+	Metrics    map[string]Threshold `yaml:"metrics,omitempty"`
+}
+
+// ResourcesPolicy contains the thresholds for the resource usage keyed by container kind,
+// as returned by getContainerKind.
+type ResourcesPolicy struct {
+	Default    ResourceThreshold            `yaml:"default"`
+	Containers map[string]ResourceThreshold `yaml:"containers,omitempty"`
+}
+
+// LogsPolicy contains the thresholds for the log generation speed keyed by log file.
+type LogsPolicy struct {
+	Default Threshold            `yaml:"default"`
+	Files   map[string]Threshold `yaml:"files,omitempty"`
+}
+
+// Policy defines the allowed variance for every kind of data compared.
+type Policy struct {
+	Metrics   MetricsPolicy   `yaml:"metrics"`
+	Resources ResourcesPolicy `yaml:"resources"`
+	Logs      LogsPolicy      `yaml:"logs"`
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+// DefaultPolicy returns the policy defined by the variance constants.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Metrics: MetricsPolicy{
+			Default: Threshold{
+				AllowedVariancePercent: float64Ptr(MetricsVarianceAllowedPercent),
+				Direction:              DirectionBoth,
+				Floor:                  float64Ptr(1),
+			},
+		},
+		Resources: ResourcesPolicy{
+			Default: ResourceThreshold{
+				CPU: Threshold{
+					AllowedVariancePercent: float64Ptr(ResourceUsageVarianceAllowedPercent),
+					Direction:              DirectionBoth,
+					Floor:                  float64Ptr(minCPU),
+				},
+				Memory: Threshold{
+					AllowedVariancePercent: float64Ptr(ResourceUsageVarianceAllowedPercent),
+					Direction:              DirectionBoth,
+					Floor:                  float64Ptr(float64(minMem)),
+				},
+			},
+		},
+		Logs: LogsPolicy{
+			Default: Threshold{
+				AllowedVariancePercent: float64Ptr(LogsGenerationVarianceAllowedPercent),
+				Direction:              DirectionBoth,
+				Floor:                  float64Ptr(minLogGeneration),
+			},
+		},
+	}
+}
+
+// LoadPolicy reads a YAML policy file. Values not defined in the file are taken from DefaultPolicy.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePolicy(data)
+}
+
+// ParsePolicy parses a YAML policy. Values not defined are taken from DefaultPolicy.
+func ParsePolicy(data []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("error parsing policy: %v", err)
+	}
+
+	defaults := DefaultPolicy()
+	policy.Metrics.Default = policy.Metrics.Default.merge(defaults.Metrics.Default)
+	policy.Resources.Default.CPU = policy.Resources.Default.CPU.merge(defaults.Resources.Default.CPU)
+	policy.Resources.Default.Memory = policy.Resources.Default.Memory.merge(defaults.Resources.Default.Memory)
+	policy.Logs.Default = policy.Logs.Default.merge(defaults.Logs.Default)
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (p *Policy) validate() error {
+	thresholds := map[string]Threshold{
+		"metrics default":       p.Metrics.Default,
+		"resources default cpu": p.Resources.Default.CPU,
+		"resources default mem": p.Resources.Default.Memory,
+		"logs default":          p.Logs.Default,
+	}
+	for k, t := range p.Metrics.Components {
+		thresholds["metrics component "+k] = t
+	}
+	for k, t := range p.Metrics.Metrics {
+		thresholds["metric "+k] = t
+	}
+	for k, t := range p.Resources.Containers {
+		thresholds["container "+k+" cpu"] = t.CPU
+		thresholds["container "+k+" memory"] = t.Memory
+	}
+	for k, t := range p.Logs.Files {
+		thresholds["log file "+k] = t
+	}
+
+	for name, t := range thresholds {
+		if t.Direction != "" && t.Direction != DirectionBoth && t.Direction != DirectionIncrease {
+			return fmt.Errorf("invalid direction %q for %v, expected %v or %v", t.Direction, name, DirectionBoth, DirectionIncrease)
+		}
+		if t.AllowedVariancePercent != nil && *t.AllowedVariancePercent < 0 {
+			return fmt.Errorf("invalid allowed variance %v for %v", *t.AllowedVariancePercent, name)
+		}
+	}
+	return nil
+}
+
+// merge returns the threshold with the fields that are not set taken from fallback.
+func (t Threshold) merge(fallback Threshold) Threshold {
+	if t.AllowedVariancePercent == nil {
+		t.AllowedVariancePercent = fallback.AllowedVariancePercent
+	}
+	if t.Direction == "" {
+		t.Direction = fallback.Direction
+	}
+	if t.Floor == nil {
+		t.Floor = fallback.Floor
+	}
+	return t
+}
+
+// allowedVariance returns the maximal ratio between the greater and the smaller value.
+func (t Threshold) allowedVariance() float64 {
+	if t.AllowedVariancePercent == nil {
+		return 1
+	}
+	return (100 + *t.AllowedVariancePercent) / 100
+}
+
+func (t Threshold) floor() float64 {
+	if t.Floor == nil {
+		return 0
+	}
+	return *t.Floor
+}
+
+// similarEnough checks if left and right are within the allowed variance, using the given
+// allowed variance instead of the one in the threshold.
+func (t Threshold) similarEnough(left, right, allowedVariance float64) bool {
+	left = math.Max(left, t.floor())
+	right = math.Max(right, t.floor())
+	if t.Direction == DirectionIncrease {
+		return leq(right, left*allowedVariance)
+	}
+	return leq(left, right*allowedVariance) && leq(right, left*allowedVariance)
+}
+
+// MetricThreshold returns the threshold for a metric of a component.
+func (p *Policy) MetricThreshold(metric, component string) Threshold {
+	t := p.Metrics.Default
+	if c, ok := p.Metrics.Components[component]; ok {
+		t = c.merge(t)
+	}
+	if m, ok := p.Metrics.Metrics[metric]; ok {
+		t = m.merge(t)
+	}
+	return t
+}
+
+// ResourceThreshold returns the threshold for the given container kind.
+func (p *Policy) ResourceThreshold(containerKind string) ResourceThreshold {
+	t := p.Resources.Default
+	if c, ok := p.Resources.Containers[containerKind]; ok {
+		t = ResourceThreshold{
+			CPU:    c.CPU.merge(t.CPU),
+			Memory: c.Memory.merge(t.Memory),
+		}
+	}
+	return t
+}
+
+// LogThreshold returns the threshold for the given log file.
+func (p *Policy) LogThreshold(file string) Threshold {
+	t := p.Logs.Default
+	if f, ok := p.Logs.Files[file]; ok {
+		t = f.merge(t)
+	}
+	return t
+}
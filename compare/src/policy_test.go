@@ -0,0 +1,217 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/test/e2e"
+)
+
+const testPolicy = `
+metrics:
+  default:
+    allowedVariancePercent: 20
+  components:
+    Kubelet:
+      allowedVariancePercent: 100
+  metrics:
+    apiserver_request_latencies_summary:
+      direction: increase
+      floor: 1000
+resources:
+  containers:
+    kube-proxy:
+      cpu:
+        allowedVariancePercent: 10
+      memory:
+        floor: 104857600
+logs:
+  files:
+    /var/log/kubelet.log:
+      allowedVariancePercent: 200
+`
+
+func TestParsePolicy(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		policy string
+		valid  bool
+	}{
+		{desc: "empty policy", policy: "", valid: true},
+		{desc: "complete policy", policy: testPolicy, valid: true},
+		{desc: "invalid yaml", policy: "metrics: [", valid: false},
+		{desc: "invalid direction", policy: "metrics:\n  default:\n    direction: decrease\n", valid: false},
+		{desc: "negative variance", policy: "logs:\n  files:\n    a.log:\n      allowedVariancePercent: -1\n", valid: false},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParsePolicy([]byte(tc.policy))
+		if tc.valid && err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.desc, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%v: expected an error", tc.desc)
+		}
+	}
+}
+
+func TestPolicyThresholds(t *testing.T) {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		desc      string
+		threshold Threshold
+		variance  float64
+		direction string
+		floor     float64
+	}{
+		{
+			desc:      "default metric",
+			threshold: policy.MetricThreshold("scheduler_e2e_scheduling_latency_microseconds", "Scheduler"),
+			variance:  1.2, direction: DirectionBoth, floor: 1,
+		},
+		{
+			desc:      "component",
+			threshold: policy.MetricThreshold("go_goroutines", KubeletComponent),
+			variance:  2, direction: DirectionBoth, floor: 1,
+		},
+		{
+			desc:      "metric name",
+			threshold: policy.MetricThreshold("apiserver_request_latencies_summary", "ApiServer"),
+			variance:  1.2, direction: DirectionIncrease, floor: 1000,
+		},
+		{
+			desc:      "default container cpu",
+			threshold: policy.ResourceThreshold("etcd").CPU,
+			variance:  1.5, direction: DirectionBoth, floor: minCPU,
+		},
+		{
+			desc:      "container kind cpu",
+			threshold: policy.ResourceThreshold("kube-proxy").CPU,
+			variance:  1.1, direction: DirectionBoth, floor: minCPU,
+		},
+		{
+			desc:      "container kind memory",
+			threshold: policy.ResourceThreshold("kube-proxy").Memory,
+			variance:  1.5, direction: DirectionBoth, floor: 104857600,
+		},
+		{
+			desc:      "default log file",
+			threshold: policy.LogThreshold("/var/log/kube-proxy.log"),
+			variance:  1.5, direction: DirectionBoth, floor: minLogGeneration,
+		},
+		{
+			desc:      "log file",
+			threshold: policy.LogThreshold("/var/log/kubelet.log"),
+			variance:  3, direction: DirectionBoth, floor: minLogGeneration,
+		},
+	}
+
+	for _, tc := range testCases {
+		if v := tc.threshold.allowedVariance(); v != tc.variance {
+			t.Errorf("%v: expected allowed variance %v but got %v", tc.desc, tc.variance, v)
+		}
+		if tc.threshold.Direction != tc.direction {
+			t.Errorf("%v: expected direction %v but got %v", tc.desc, tc.direction, tc.threshold.Direction)
+		}
+		if f := tc.threshold.floor(); f != tc.floor {
+			t.Errorf("%v: expected floor %v but got %v", tc.desc, tc.floor, f)
+		}
+	}
+}
+
+func TestThresholdSimilarEnough(t *testing.T) {
+	both := Threshold{AllowedVariancePercent: float64Ptr(50), Direction: DirectionBoth, Floor: float64Ptr(10)}
+	increase := Threshold{AllowedVariancePercent: float64Ptr(50), Direction: DirectionIncrease, Floor: float64Ptr(10)}
+
+	testCases := []struct {
+		desc        string
+		threshold   Threshold
+		left, right float64
+		similar     bool
+	}{
+		{desc: "equal", threshold: both, left: 100, right: 100, similar: true},
+		{desc: "within variance", threshold: both, left: 100, right: 150, similar: true},
+		{desc: "increase", threshold: both, left: 100, right: 151, similar: false},
+		{desc: "decrease", threshold: both, left: 151, right: 100, similar: false},
+		{desc: "below the floor", threshold: both, left: 1, right: 9, similar: true},
+		{desc: "increase only, increase", threshold: increase, left: 100, right: 151, similar: false},
+		{desc: "increase only, decrease", threshold: increase, left: 1000, right: 100, similar: true},
+	}
+
+	for _, tc := range testCases {
+		if similar := tc.threshold.similarEnough(tc.left, tc.right, tc.threshold.allowedVariance()); similar != tc.similar {
+			t.Errorf("%v: expected %v but got %v", tc.desc, tc.similar, similar)
+		}
+	}
+}
+
+func TestCompareWithPolicy(t *testing.T) {
+	left := e2e.ResourceUsageSummary{
+		"90": []e2e.SingleContainerSummary{
+			{Name: "kube-proxy-a/kube-proxy", Cpu: 0.1, Mem: 10000000},
+			{Name: "kube-dns-a/etcd", Cpu: 0.1, Mem: 10000000},
+		},
+	}
+	right := e2e.ResourceUsageSummary{
+		"90": []e2e.SingleContainerSummary{
+			{Name: "kube-proxy-b/kube-proxy", Cpu: 0.12, Mem: 60000000},
+			{Name: "kube-dns-b/etcd", Cpu: 0.12, Mem: 60000000},
+		},
+	}
+
+	testCases := []struct {
+		desc      string
+		policy    string
+		violating []string
+	}{
+		{desc: "default policy", policy: "", violating: []string{}},
+		{desc: "container cpu", policy: "resources:\n  containers:\n    kube-proxy:\n      cpu:\n        allowedVariancePercent: 10\n", violating: []string{"kube-proxy"}},
+		{desc: "default memory floor", policy: "resources:\n  default:\n    memory:\n      floor: 1000000\n", violating: []string{"etcd", "kube-proxy"}},
+		{desc: "decreases are allowed", policy: "resources:\n  default:\n    cpu:\n      allowedVariancePercent: 0\n      direction: increase\n", violating: []string{"etcd", "kube-proxy"}},
+	}
+
+	for _, tc := range testCases {
+		policy, err := ParsePolicy([]byte(tc.policy))
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tc.desc, err)
+		}
+		violating := CompareResourceUsagesWithPolicy(&left, &right, policy)
+		if len(violating) != len(tc.violating) {
+			t.Errorf("%v: expected violations in %v but got %v", tc.desc, tc.violating, violating)
+			continue
+		}
+		for _, container := range tc.violating {
+			if _, ok := violating[container]; !ok {
+				t.Errorf("%v: expected a violation for %v but got %v", tc.desc, container, violating)
+			}
+		}
+	}
+
+	// Decreases are not flagged when the direction is increase
+	policy, err := ParsePolicy([]byte("resources:\n  default:\n    cpu:\n      allowedVariancePercent: 0\n      direction: increase\n    memory:\n      direction: increase\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if violating := CompareResourceUsagesWithPolicy(&right, &left, policy); len(violating) != 0 {
+		t.Errorf("Expected no violations for decreases but got %v", violating)
+	}
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// LogsKind identifies violations in the log generation speed.
+	LogsKind = "logs"
+	// ResourcesKind identifies violations in the resource usage of containers.
+	ResourcesKind = "resources"
+	// MetricsKind identifies violations in the metrics of the components.
+	MetricsKind = "metrics"
+)
+
+// Violation is a single offending pair of values in a form that can be serialized.
+type Violation struct {
+	Test string `json:"test"`
+	Kind string `json:"kind"`
+	// Name is the metric name, the container kind or the log file
+	Name      string  `json:"name"`
+	Component string  `json:"component,omitempty"`
+	Labels    string  `json:"labels,omitempty"`
+	Left      float64 `json:"left"`
+	Right     float64 `json:"right"`
+	// Stddev is the standard deviation of the baseline when comparing against several builds.
+	// Left is the mean of the baseline in that case.
+	Stddev *float64 `json:"stddev,omitempty"`
+}
+
+func (v Violation) String() string {
+	if v.Stddev != nil {
+		return fmt.Sprintf("%v %v {%v}: %v (stddev %.2f) vs %v", v.Name, v.Component, v.Labels, v.Left, *v.Stddev, v.Right)
+	}
+	return fmt.Sprintf("%v %v {%v}: %v vs %v", v.Name, v.Component, v.Labels, v.Left, v.Right)
+}
+
+type violationArr []Violation
+
+func (a violationArr) Len() int      { return len(a) }
+func (a violationArr) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a violationArr) Less(i, j int) bool {
+	if a[i].Name != a[j].Name {
+		return a[i].Name < a[j].Name
+	}
+	if a[i].Component != a[j].Component {
+		return a[i].Component < a[j].Component
+	}
+	return a[i].Labels < a[j].Labels
+}
+
+// Violations converts the offending metrics to a sorted list of Violations.
+func (d ViolatingMetricsArr) Violations(test string) []Violation {
+	result := []Violation{}
+	for metric, arr := range d {
+		for _, data := range arr {
+			result = append(result, Violation{
+				Test:      test,
+				Kind:      MetricsKind,
+				Name:      metric,
+				Component: data.component,
+				Labels:    data.labels,
+				Left:      data.left,
+				Right:     data.right,
+			})
+		}
+	}
+	sort.Sort(violationArr(result))
+	return result
+}
+
+// Violations converts the offending resource usage to a sorted list of Violations, with
+// minimums and maximums of CPU and memory as separate entries.
+func (d ViolatingResourceUsageData) Violations(test string) []Violation {
+	result := []Violation{}
+	for container, v := range d {
+		newViolation := func(resource, aggregate string, left, right float64) Violation {
+			return Violation{
+				Test:      test,
+				Kind:      ResourcesKind,
+				Name:      container,
+				Component: resource,
+				Labels:    fmt.Sprintf("percentile=%v, aggregate=%v", v.percentile, aggregate),
+				Left:      left,
+				Right:     right,
+			}
+		}
+		if len(v.leftCPUData) != 0 && len(v.rightCPUData) != 0 {
+			result = append(result,
+				newViolation("cpu", "min", v.leftCPUData[0], v.rightCPUData[0]),
+				newViolation("cpu", "max", v.leftCPUData[len(v.leftCPUData)-1], v.rightCPUData[len(v.rightCPUData)-1]))
+		}
+		if len(v.leftMemData) != 0 && len(v.rightMemData) != 0 {
+			result = append(result,
+				newViolation("memory", "min", float64(v.leftMemData[0]), float64(v.rightMemData[0])),
+				newViolation("memory", "max", float64(v.leftMemData[len(v.leftMemData)-1]), float64(v.rightMemData[len(v.rightMemData)-1])))
+		}
+	}
+	sort.Sort(violationArr(result))
+	return result
+}
+
+// Violations converts the offending log generation speeds to a sorted list of Violations,
+// with minimums and maximums as separate entries. The component contains the nodes.
+func (d ViolatingLogGenerationData) Violations(test string) []Violation {
+	result := []Violation{}
+	for file, v := range d {
+		if len(v.left) == 0 || len(v.right) == 0 {
+			continue
+		}
+		leftMin, leftMax := v.left[0], v.left[len(v.left)-1]
+		rightMin, rightMax := v.right[0], v.right[len(v.right)-1]
+		result = append(result,
+			Violation{
+				Test:      test,
+				Kind:      LogsKind,
+				Name:      file,
+				Component: fmt.Sprintf("%v#%v", leftMin.node, rightMin.node),
+				Labels:    "aggregate=min",
+				Left:      leftMin.generationRate,
+				Right:     rightMin.generationRate,
+			},
+			Violation{
+				Test:      test,
+				Kind:      LogsKind,
+				Name:      file,
+				Component: fmt.Sprintf("%v#%v", leftMax.node, rightMax.node),
+				Labels:    "aggregate=max",
+				Left:      leftMax.generationRate,
+				Right:     rightMax.generationRate,
+			})
+	}
+	sort.Sort(violationArr(result))
+	return result
+}
+
+// Check is the result of comparing one kind of data of a single test.
+type Check struct {
+	Test       string      `json:"test"`
+	Kind       string      `json:"kind"`
+	Missing    bool        `json:"missing,omitempty"`
+	Violations []Violation `json:"violations"`
+}
+
+// Report contains the results of all the comparisons between two builds, or between a build
+// and the baseline builds.
+type Report struct {
+	LeftBuild      int     `json:"leftBuild,omitempty"`
+	RightBuild     int     `json:"rightBuild"`
+	BaselineBuilds []int   `json:"baselineBuilds,omitempty"`
+	Checks         []Check `json:"checks"`
+	// SummaryErrors contains the summaries of the compared builds that couldn't be extracted
+	SummaryErrors []*SummaryError `json:"summaryErrors,omitempty"`
+}
+
+// description returns the builds compared in the report
+func (r *Report) description() string {
+	if len(r.BaselineBuilds) != 0 {
+		return fmt.Sprintf("compare %v vs baseline %v", r.RightBuild, r.BaselineBuilds)
+	}
+	return fmt.Sprintf("compare %v vs %v", r.LeftBuild, r.RightBuild)
+}
+
+// NewReport creates an empty Report for the comparison of two builds.
+func NewReport(leftBuild, rightBuild int) *Report {
+	return &Report{
+		LeftBuild:  leftBuild,
+		RightBuild: rightBuild,
+		Checks:     []Check{},
+	}
+}
+
+// Add records the violations found for the given test and kind of data.
+func (r *Report) Add(test, kind string, violations []Violation) {
+	if violations == nil {
+		violations = []Violation{}
+	}
+	r.Checks = append(r.Checks, Check{Test: test, Kind: kind, Violations: violations})
+}
+
+// AddMissing records that one side of the comparison has no data for the given test and kind.
+func (r *Report) AddMissing(test, kind string) {
+	r.Checks = append(r.Checks, Check{Test: test, Kind: kind, Missing: true, Violations: []Violation{}})
+}
+
+// AddSummaryErrors records the summaries that couldn't be extracted from the logs of the builds.
+func (r *Report) AddSummaryErrors(builds ...*BuildSummaries) {
+	for _, b := range builds {
+		r.SummaryErrors = append(r.SummaryErrors, b.Errors...)
+	}
+}
+
+// HasViolations returns true if any of the checks found offending values.
+func (r *Report) HasViolations() bool {
+	for _, c := range r.Checks {
+		if len(c.Violations) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes the violations as a table, used when comparing against the baseline builds.
+func (r *Report) WriteText(w io.Writer) error {
+	writer := tabwriter.NewWriter(w, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(writer, "%v\n", r.description())
+	for _, c := range r.Checks {
+		if c.Missing {
+			fmt.Fprintf(writer, "Baseline %v missing for test %v\n", c.Kind, c.Test)
+			continue
+		}
+		if len(c.Violations) == 0 {
+			continue
+		}
+		fmt.Fprintf(writer, "\nDifferences in %v for test %v\n", c.Kind, c.Test)
+		fmt.Fprint(writer, "Name\tComponent\tLabels\tBaseline mean\tStddev\tValue\t\n")
+		for _, v := range c.Violations {
+			stddev := 0.0
+			if v.Stddev != nil {
+				stddev = *v.Stddev
+			}
+			fmt.Fprintf(writer, "%v\t%v\t%v\t%.2f\t%.2f\t%.2f\t\n", v.Name, v.Component, v.Labels, v.Left, stddev, v.Right)
+		}
+	}
+	return writer.Flush()
+}
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	return encoder.Encode(r)
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as a JUnit test suite with a test case for each check.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:      r.description(),
+		TestCases: []junitTestCase{},
+	}
+	for _, c := range r.Checks {
+		testCase := junitTestCase{Name: c.Test, ClassName: c.Kind}
+		switch {
+		case c.Missing:
+			testCase.Skipped = &junitMessage{Message: fmt.Sprintf("%v missing in one of the builds", c.Kind)}
+			suite.Skipped++
+		case len(c.Violations) != 0:
+			lines := []string{}
+			for _, v := range c.Violations {
+				lines = append(lines, v.String())
+			}
+			testCase.Failure = &junitMessage{
+				Message: fmt.Sprintf("%d values differ more than allowed", len(c.Violations)),
+				Text:    strings.Join(lines, "\n"),
+			}
+			suite.Failures++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	for _, e := range r.SummaryErrors {
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      e.Test,
+			ClassName: e.Summary,
+			Failure:   &junitMessage{Message: "summary couldn't be extracted", Text: e.Error()},
+		})
+		suite.Failures++
+	}
+	suite.Tests = len(suite.TestCases)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
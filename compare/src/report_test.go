@@ -0,0 +1,126 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestViolations(t *testing.T) {
+	metrics := ViolatingMetricsArr{
+		"apiserver_request_latencies_summary": {
+			{labels: "quantile=0.99, resource=pods, verb=LIST", component: "ApiServer", left: 100, right: 300},
+		},
+	}
+	expectedMetrics := []Violation{
+		{Test: "density", Kind: MetricsKind, Name: "apiserver_request_latencies_summary", Component: "ApiServer",
+			Labels: "quantile=0.99, resource=pods, verb=LIST", Left: 100, Right: 300},
+	}
+	if v := metrics.Violations("density"); !reflect.DeepEqual(v, expectedMetrics) {
+		t.Errorf("Expected %v but got %v", expectedMetrics, v)
+	}
+
+	resources := ViolatingResourceUsageData{
+		"kube-proxy": {
+			percentile:   "99",
+			leftCPUData:  []float64{0.1, 0.2},
+			rightCPUData: []float64{0.1, 0.5},
+			leftMemData:  []int64{10, 20},
+			rightMemData: []int64{10, 20},
+		},
+	}
+	v := resources.Violations("density")
+	if len(v) != 4 {
+		t.Fatalf("Expected 4 violations but got %v", v)
+	}
+	if v[0].Component != "cpu" || v[0].Labels != "percentile=99, aggregate=max" || v[0].Left != 0.2 || v[0].Right != 0.5 {
+		t.Errorf("Unexpected violation for the max cpu: %v", v[0])
+	}
+
+	logs := ViolatingLogGenerationData{
+		"/var/log/kubelet.log": {
+			left:  logsDataArray{{node: "a", generationRate: 10}, {node: "b", generationRate: 20}},
+			right: logsDataArray{{node: "c", generationRate: 10}, {node: "d", generationRate: 80}},
+		},
+	}
+	expectedLogs := []Violation{
+		{Test: "density", Kind: LogsKind, Name: "/var/log/kubelet.log", Component: "a#c", Labels: "aggregate=min", Left: 10, Right: 10},
+		{Test: "density", Kind: LogsKind, Name: "/var/log/kubelet.log", Component: "b#d", Labels: "aggregate=max", Left: 20, Right: 80},
+	}
+	if v := logs.Violations("density"); !reflect.DeepEqual(v, expectedLogs) {
+		t.Errorf("Expected %v but got %v", expectedLogs, v)
+	}
+
+	var empty ViolatingMetricsArr
+	if v := empty.Violations("density"); v == nil || len(v) != 0 {
+		t.Errorf("Expected an empty list but got %v", v)
+	}
+}
+
+func newTestReport() *Report {
+	report := NewReport(1, 2)
+	report.Add("density", MetricsKind, []Violation{
+		{Test: "density", Kind: MetricsKind, Name: "apiserver_request_count", Component: "ApiServer", Left: 1, Right: 10},
+	})
+	report.Add("density", ResourcesKind, nil)
+	report.AddMissing("load", LogsKind)
+	return report
+}
+
+func TestReportJSON(t *testing.T) {
+	report := newTestReport()
+	if !report.HasViolations() {
+		t.Error("Expected the report to have violations")
+	}
+
+	buff := &bytes.Buffer{}
+	if err := report.WriteJSON(buff); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := &Report{}
+	if err := json.Unmarshal(buff.Bytes(), decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, report) {
+		t.Errorf("Expected %+v but got %+v", report, decoded)
+	}
+
+	if NewReport(1, 2).HasViolations() {
+		t.Error("Expected an empty report not to have violations")
+	}
+}
+
+func TestReportJUnit(t *testing.T) {
+	buff := &bytes.Buffer{}
+	if err := newTestReport().WriteJUnit(buff); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	suite := junitTestSuite{}
+	if err := xml.Unmarshal(buff.Bytes(), &suite); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if suite.Tests != 3 || suite.Failures != 1 || suite.Skipped != 1 {
+		t.Errorf("Expected 3 tests, 1 failure and 1 skipped but got %v, %v and %v", suite.Tests, suite.Failures, suite.Skipped)
+	}
+	if len(suite.TestCases) != 3 || suite.TestCases[0].Failure == nil || suite.TestCases[1].Failure != nil || suite.TestCases[2].Skipped == nil {
+		t.Errorf("Unexpected test cases: %+v", suite.TestCases)
+	}
+}
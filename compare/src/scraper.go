@@ -18,86 +18,201 @@ package src
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/json"
+	"fmt"
+	"regexp"
 	"strings"
 
+	"k8s.io/contrib/compare/src/jsonreader"
 	"k8s.io/kubernetes/test/e2e"
 
 	"github.com/golang/glog"
 )
 
 const (
-	defaultState     = iota
-	readingLogs      = iota
-	readingResources = iota
-	readingMetrics   = iota
+	logsSummaryName      = "LogsSizeDataSummary"
+	resourcesSummaryName = "ResourceUsageSummary"
+	metricsSummaryName   = "MetricsForE2E"
+
+	// summaryBeginSuffix follows the name of the summary in the line printed before the JSON.
+	summaryBeginSuffix = " JSON"
+	// summaryEnd is the message printed after the JSON.
+	summaryEnd = "Finished"
+
+	// jsonValue matches a number or a literal of a JSON document.
+	jsonValue = `-?[0-9][0-9.eE+\-]*|true|false|null`
+	// jsonValues matches a list of numbers or literals separated by commas.
+	jsonValues = `(` + jsonValue + `)(\s*,\s*(` + jsonValue + `))*`
 )
 
-// ProcessSingleTest processes single Jenkins output file, reading and parsing JSON
-// summaries embedded in it.
-func ProcessSingleTest(scanner *bufio.Scanner, buildNumber int) (map[string]*e2e.LogsSizeDataSummary, map[string]*e2e.ResourceUsageSummary, map[string]*e2e.MetricsForE2E) {
-	buff := &bytes.Buffer{}
-	logSummary := make(map[string]*e2e.LogsSizeDataSummary)
-	resourceSummary := make(map[string]*e2e.ResourceUsageSummary)
-	metricsSummary := make(map[string]*e2e.MetricsForE2E)
-	state := defaultState
-	oldTestSeparator := "[It] [Performance] "
-	testSeparator := "[It] [Feature:Performance] "
+var (
+	// testSeparators precede the name of the performance tests, newest first.
+	testSeparators = []string{"[It] [Feature:Performance] ", "[It] [Performance] "}
+
+	// jsonStart matches the first line of a summary, which starts with its object or array.
+	jsonStart = regexp.MustCompile(`^\s*[{\[]`)
+	// jsonLine matches the lines of a pretty-printed JSON document. Other lines found while
+	// reading a summary are output of other components interleaved with it. A bracket starts
+	// an array only if it is followed by a value or the end of the array, unlike the ones of
+	// lines like "[AfterEach] [k8s.io] Density". Lines of numbers and literals contain only
+	// values, unlike lines starting with a timestamp like "12:00:01 {".
+	jsonLine = regexp.MustCompile(`^\s*([{}\]"]|\[(\s|[\]{"]|$)|\[?\s*` + jsonValues + `\s*\]?\s*,?\s*$)`)
+)
+
+// SummaryError describes a summary found in the log that could not be extracted.
+type SummaryError struct {
+	Build int    `json:"build"`
+	Test  string `json:"test"`
+	// Summary is the name of the summary type, eg. MetricsForE2E
+	Summary string `json:"summary"`
+	// Line where the summary starts
+	Line int `json:"line"`
+	// Partial is true if the summary was interrupted before the JSON was complete, false if
+	// the JSON is complete but can't be parsed.
+	Partial bool   `json:"partial"`
+	Reason  string `json:"reason"`
+}
+
+func (e *SummaryError) Error() string {
+	kind := "corrupt"
+	if e.Partial {
+		kind = "partial"
+	}
+	return fmt.Sprintf("%v %v in build %d, test %q, line %d: %v", kind, e.Summary, e.Build, e.Test, e.Line, e.Reason)
+}
+
+// summaryReader accumulates the JSON of a summary until its braces are balanced.
+type summaryReader struct {
+	jsonreader.Reader
+	name string
+	test string
+	line int
+}
+
+// isSummaryEnd checks if the line is the message logged after a summary, either bare or with
+// the timestamp and level of the e2e framework.
+func isSummaryEnd(line string) bool {
+	line = strings.TrimSpace(line)
+	return line == summaryEnd || strings.HasSuffix(line, "INFO: "+summaryEnd)
+}
+
+// getSummaryName returns the name of the summary that starts in the line, if any.
+func getSummaryName(line string) string {
+	for _, name := range []string{logsSummaryName, resourcesSummaryName, metricsSummaryName} {
+		if strings.HasSuffix(strings.TrimSpace(line), name+summaryBeginSuffix) {
+			return name
+		}
+	}
+	return ""
+}
+
+// getTestName returns the name of the test that starts in the line, if any.
+func getTestName(line string) string {
+	for _, separator := range testSeparators {
+		if i := strings.Index(line, separator); i != -1 {
+			return strings.TrimSpace(line[i+len(separator):])
+		}
+	}
+	return ""
+}
+
+// ExtractSummaries reads a Jenkins output file and parses the JSON summaries embedded in it.
+// A summary starts after a line ending in "<summary type> JSON" and ends when the braces of the
+// JSON are balanced, so output of other components interleaved with the JSON, or a missing
+// "Finished" line, don't corrupt it. Summaries that are interrupted or can't be parsed are
+// recorded in the Errors of the result.
+func ExtractSummaries(scanner *bufio.Scanner, buildNumber int) *BuildSummaries {
+	result := &BuildSummaries{
+		Build:     buildNumber,
+		Logs:      make(map[string]*e2e.LogsSizeDataSummary),
+		Resources: make(map[string]*e2e.ResourceUsageSummary),
+		Metrics:   make(map[string]*e2e.MetricsForE2E),
+	}
+	newError := func(r *summaryReader, partial bool, reason string) {
+		result.Errors = append(result.Errors, &SummaryError{
+			Build:   buildNumber,
+			Test:    r.test,
+			Summary: r.name,
+			Line:    r.line,
+			Partial: partial,
+			Reason:  reason,
+		})
+	}
+
+	var reader *summaryReader
 	testName := ""
+	lineNumber := 0
 	for scanner.Scan() {
 		line := scanner.Text()
-		if state == defaultState {
-			separator := ""
-			if strings.Contains(line, testSeparator) {
-				separator = testSeparator
-			} else if strings.Contains(line, oldTestSeparator) {
-				separator = oldTestSeparator
-			}
-			if separator != "" {
-				testName = strings.Trim(strings.Split(line, separator)[1], " ")
-				buff.Reset()
+		lineNumber++
+
+		if name := getTestName(line); name != "" {
+			if reader != nil {
+				newError(reader, true, fmt.Sprintf("test %q started at line %d", name, lineNumber))
+				reader = nil
 			}
+			testName = name
+			continue
 		}
-		if strings.Contains(line, "Finished") {
-			if state == readingLogs {
-				logSummary[testName] = &e2e.LogsSizeDataSummary{}
-				state = defaultState
-				if err := json.Unmarshal(buff.Bytes(), logSummary[testName]); err != nil {
-					glog.V(0).Infof("error parsing LogsSizeDataSummary JSON in build %d: %v %s\n", buildNumber, err, buff.String())
-					continue
-				}
-			}
-			if state == readingResources {
-				resourceSummary[testName] = &e2e.ResourceUsageSummary{}
-				state = defaultState
-				if err := json.Unmarshal(buff.Bytes(), resourceSummary[testName]); err != nil {
-					glog.V(0).Infof("error parsing ResourceUsageSummary JSON in build %d: %v %s\n", buildNumber, err, buff.String())
-					continue
-				}
-			}
-			if state == readingMetrics {
-				metricsSummary[testName] = &e2e.MetricsForE2E{}
-				state = defaultState
-				if err := json.Unmarshal(buff.Bytes(), metricsSummary[testName]); err != nil {
-					glog.V(0).Infof("error parsing MetricsForE2E JSON in build %d: %v %s\n", buildNumber, err, buff.String())
-					continue
-				}
+		if name := getSummaryName(line); name != "" {
+			if reader != nil {
+				newError(reader, true, fmt.Sprintf("%v started at line %d", name, lineNumber))
 			}
-			buff.Reset()
+			reader = &summaryReader{name: name, test: testName, line: lineNumber}
+			continue
+		}
+		if reader == nil {
+			continue
+		}
+		if isSummaryEnd(line) {
+			newError(reader, true, fmt.Sprintf("%v at line %d before the end of the JSON", summaryEnd, lineNumber))
+			reader = nil
+			continue
 		}
-		if state != defaultState {
-			buff.WriteString(line + " ")
+		if !jsonLine.MatchString(line) || !reader.Started() && !jsonStart.MatchString(line) {
+			glog.V(4).Infof("Skipping line %d interleaved with %v in build %d: %v", lineNumber, reader.name, buildNumber, line)
+			continue
 		}
-		if strings.Contains(line, "LogsSizeDataSummary JSON") {
-			state = readingLogs
+		if !reader.Add(line) {
+			continue
 		}
-		if strings.Contains(line, "ResourceUsageSummary JSON") {
-			state = readingResources
+
+		var err error
+		switch reader.name {
+		case logsSummaryName:
+			summary := &e2e.LogsSizeDataSummary{}
+			if err = json.Unmarshal(reader.Bytes(), summary); err == nil {
+				result.Logs[reader.test] = summary
+			}
+		case resourcesSummaryName:
+			summary := &e2e.ResourceUsageSummary{}
+			if err = json.Unmarshal(reader.Bytes(), summary); err == nil {
+				result.Resources[reader.test] = summary
+			}
+		case metricsSummaryName:
+			summary := &e2e.MetricsForE2E{}
+			if err = json.Unmarshal(reader.Bytes(), summary); err == nil {
+				result.Metrics[reader.test] = summary
+			}
 		}
-		if strings.Contains(line, "MetricsForE2E JSON") {
-			state = readingMetrics
+		if err != nil {
+			newError(reader, false, err.Error())
 		}
+		reader = nil
+	}
+	if reader != nil {
+		newError(reader, true, "end of the log before the end of the JSON")
+	}
+
+	return result
+}
+
+// ProcessSingleTest processes single Jenkins output file, reading and parsing JSON
+// summaries embedded in it. Summaries that can't be extracted are logged and skipped.
+func ProcessSingleTest(scanner *bufio.Scanner, buildNumber int) (map[string]*e2e.LogsSizeDataSummary, map[string]*e2e.ResourceUsageSummary, map[string]*e2e.MetricsForE2E) {
+	summaries := ExtractSummaries(scanner, buildNumber)
+	for _, err := range summaries.Errors {
+		glog.V(0).Infof("error extracting summary: %v", err)
 	}
-	return logSummary, resourceSummary, metricsSummary
+	return summaries.Logs, summaries.Resources, summaries.Metrics
 }
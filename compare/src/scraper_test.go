@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	k8smetrics "k8s.io/kubernetes/pkg/metrics"
@@ -374,3 +375,181 @@ func TestProcessSingleTestMetrics(t *testing.T) {
 		}
 	}
 }
+
+func TestExtractSummariesInterleaved(t *testing.T) {
+	file, err := os.Open("test-data/interleaved.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	summaries := ExtractSummaries(bufio.NewScanner(file), 123)
+	expectedResources := &e2e.ResourceUsageSummary{
+		"90": []e2e.SingleContainerSummary{
+			{Name: "kube-proxy-node-1/kube-proxy", Cpu: 0.05, Mem: 12345678},
+			{Name: "fluentd-node-1/fluentd {\"escaped\"", Cpu: 0.1, Mem: 87654321},
+		},
+	}
+	if !reflect.DeepEqual(summaries.Resources["Interleaved test"], expectedResources) {
+		t.Errorf("Parsed resources do not match expected value:\nReceived:\n%v\nExpected:\n%v", summaries.Resources["Interleaved test"], expectedResources)
+	}
+	expectedLogs := &e2e.LogsSizeDataSummary{
+		"104.154.36.5:22": map[string]e2e.SingleLogSummary{
+			"/var/log/kubelet.log": {AverageGenerationRate: 24361, NumberOfProbes: 2},
+		},
+	}
+	if !reflect.DeepEqual(summaries.Logs["Old separator test"], expectedLogs) {
+		t.Errorf("Parsed logs do not match expected value:\nReceived:\n%v\nExpected:\n%v", summaries.Logs["Old separator test"], expectedLogs)
+	}
+	if len(summaries.Metrics) != 0 {
+		t.Errorf("Received non-empty metrics: %v", summaries.Metrics)
+	}
+	expectedErrors := []*SummaryError{
+		{Build: 123, Test: "Interleaved test", Summary: metricsSummaryName, Line: 21, Partial: true, Reason: "Finished at line 25 before the end of the JSON"},
+	}
+	if !reflect.DeepEqual(summaries.Errors, expectedErrors) {
+		t.Errorf("Errors do not match expected value:\nReceived:\n%v\nExpected:\n%v", summaries.Errors, expectedErrors)
+	}
+}
+
+func TestJSONLine(t *testing.T) {
+	tests := []struct {
+		line string
+		json bool
+	}{
+		{"{", true},
+		{"  }", true},
+		{"  ],", true},
+		{"  [", true},
+		{"  [ 1, 2 ]", true},
+		{"  []", true},
+		{"  [{", true},
+		{`  ["a"`, true},
+		{"  [0.5,", true},
+		{`  "key": 12,`, true},
+		{"  -1", true},
+		{"  12,", true},
+		{"  1.5e+06", true},
+		{"  [ 1, 2 ],", true},
+		{"  true", true},
+		{"  null", true},
+		{"12:00:01 {", false},
+		{"12:00:01 INFO: Waiting for pods", false},
+		{"[12:00:01] {", false},
+		{"2016-01-13 15:59:05 [", false},
+		{"true story", false},
+		{"[It] [Feature:Performance] Test", false},
+		{"[AfterEach] [k8s.io] Density", false},
+		{"[k8s.io] Density should allow starting 30 pods per node", false},
+		{"Jan 13 15:59:05.884: INFO: Waiting for pods", false},
+		{"STEP: Collecting resource usage data", false},
+	}
+	for _, test := range tests {
+		if jsonLine.MatchString(test.line) != test.json {
+			t.Errorf("expected JSON %v for %q", test.json, test.line)
+		}
+	}
+}
+
+func TestExtractSummariesInterleavedBrackets(t *testing.T) {
+	log := strings.Join([]string{
+		"[It] [Feature:Performance] Test",
+		"ResourceUsageSummary JSON",
+		"{",
+		`  "90": [`,
+		"[AfterEach] [k8s.io] Density",
+		"    {",
+		`      "Name": "kube-proxy-node-1/kube-proxy",`,
+		"[k8s.io] Density should allow starting 30 pods per node",
+		`      "Cpu": 0.05,`,
+		`      "Mem": 12345678`,
+		"    }",
+		"  ]",
+		"}",
+		"Finished",
+	}, "\n")
+	summaries := ExtractSummaries(bufio.NewScanner(strings.NewReader(log)), 1)
+	expected := &e2e.ResourceUsageSummary{
+		"90": []e2e.SingleContainerSummary{
+			{Name: "kube-proxy-node-1/kube-proxy", Cpu: 0.05, Mem: 12345678},
+		},
+	}
+	if len(summaries.Errors) != 0 || !reflect.DeepEqual(summaries.Resources["Test"], expected) {
+		t.Errorf("expected %v, got %v (errors %v)", expected, summaries.Resources["Test"], summaries.Errors)
+	}
+}
+
+func TestExtractSummariesTimestampedLines(t *testing.T) {
+	log := strings.Join([]string{
+		"[It] [Feature:Performance] Test",
+		"ResourceUsageSummary JSON",
+		`12:00:01 {"component": "kubelet"}`,
+		"{",
+		"12:00:02 [",
+		`  "90": [`,
+		"    {",
+		`      "Name": "kube-proxy-node-1/kube-proxy",`,
+		`      "Cpu": 0.05,`,
+		`      "Mem": 12345678`,
+		"    }",
+		"  ]",
+		"}",
+		"Finished",
+	}, "\n")
+	summaries := ExtractSummaries(bufio.NewScanner(strings.NewReader(log)), 1)
+	expected := &e2e.ResourceUsageSummary{
+		"90": []e2e.SingleContainerSummary{
+			{Name: "kube-proxy-node-1/kube-proxy", Cpu: 0.05, Mem: 12345678},
+		},
+	}
+	if len(summaries.Errors) != 0 || !reflect.DeepEqual(summaries.Resources["Test"], expected) {
+		t.Errorf("expected %v, got %v (errors %v)", expected, summaries.Resources["Test"], summaries.Errors)
+	}
+}
+
+func TestExtractSummariesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		log     string
+		partial bool
+		reason  string
+	}{
+		{
+			name:    "end of log",
+			log:     "[It] [Feature:Performance] Test\nMetricsForE2E JSON\n{\n  \"ApiServerMetrics\": {\n",
+			partial: true,
+			reason:  "end of the log before the end of the JSON",
+		},
+		{
+			name:    "next test",
+			log:     "[It] [Feature:Performance] Test\nMetricsForE2E JSON\n{\n[It] [Feature:Performance] Other test\n",
+			partial: true,
+			reason:  `test "Other test" started at line 4`,
+		},
+		{
+			name:    "next summary",
+			log:     "[It] [Feature:Performance] Test\nMetricsForE2E JSON\n{\nJan 13 15:59:05.884: INFO: LogsSizeDataSummary JSON\n{}\n",
+			partial: true,
+			reason:  "LogsSizeDataSummary started at line 4",
+		},
+		{
+			name:   "corrupt",
+			log:    "[It] [Feature:Performance] Test\nMetricsForE2E JSON\n{\n  \"ApiServerMetrics\": 12\n}\nFinished\n",
+			reason: "json: cannot unmarshal",
+		},
+	}
+	for _, test := range tests {
+		summaries := ExtractSummaries(bufio.NewScanner(strings.NewReader(test.log)), 1)
+		if len(summaries.Metrics) != 0 {
+			t.Errorf("%v: received non-empty metrics: %v", test.name, summaries.Metrics)
+		}
+		if len(summaries.Errors) != 1 {
+			t.Errorf("%v: expected 1 error, got %v", test.name, summaries.Errors)
+			continue
+		}
+		err := summaries.Errors[0]
+		if err.Build != 1 || err.Test != "Test" || err.Summary != metricsSummaryName || err.Line != 2 || err.Partial != test.partial || !strings.HasPrefix(err.Reason, test.reason) {
+			t.Errorf("%v: unexpected error %#v", test.name, err)
+		}
+	}
+}
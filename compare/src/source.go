@@ -0,0 +1,93 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"k8s.io/contrib/mungegithub/mungers/jenkins"
+)
+
+// BuildPlaceholder is replaced by the build number in file and URL templates.
+const BuildPlaceholder = "{build}"
+
+// LogSource provides the output of a single build of the test job.
+type LogSource interface {
+	// Open returns the build log of the given build. The caller must close it.
+	Open(buildNumber int) (io.ReadCloser, error)
+}
+
+// FileSource reads build logs from local files. PathTemplate is the path of
+// the log with BuildPlaceholder in place of the build number,
+// eg. /data/logs/{build}/build-log.txt
+type FileSource struct {
+	PathTemplate string
+}
+
+// Open implements LogSource.
+func (s *FileSource) Open(buildNumber int) (io.ReadCloser, error) {
+	path := expandBuild(s.PathTemplate, buildNumber)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening log of build %d: %v", buildNumber, err)
+	}
+	return file, nil
+}
+
+// URLSource downloads build logs over HTTP. URLTemplate is the URL of the log
+// with BuildPlaceholder in place of the build number.
+type URLSource struct {
+	URLTemplate string
+}
+
+// Open implements LogSource.
+func (s *URLSource) Open(buildNumber int) (io.ReadCloser, error) {
+	url := expandBuild(s.URLTemplate, buildNumber)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading log of build %d: %v", buildNumber, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("error downloading log of build %d: unexpected status %s for %s", buildNumber, resp.Status, url)
+	}
+	return resp.Body, nil
+}
+
+// JenkinsSource reads the consoleText of the builds of a Jenkins job.
+type JenkinsSource struct {
+	Client *jenkins.JenkinsClient
+	Job    string
+}
+
+// Open implements LogSource.
+func (s *JenkinsSource) Open(buildNumber int) (io.ReadCloser, error) {
+	body, err := s.Client.GetConsoleLog(s.Job, buildNumber)
+	if err != nil {
+		return nil, fmt.Errorf("error getting console log of build %d of %v: %v", buildNumber, s.Job, err)
+	}
+	return body, nil
+}
+
+func expandBuild(template string, buildNumber int) string {
+	return strings.Replace(template, BuildPlaceholder, strconv.Itoa(buildNumber), -1)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/contrib/mungegithub/mungers/jenkins"
+)
+
+func TestFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compare")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "123"), 0755); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "123", "build-log.txt"), []byte("build 123"), 0644); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	source := &FileSource{PathTemplate: filepath.Join(dir, BuildPlaceholder, "build-log.txt")}
+	checkSource(t, source, 123, "build 123")
+	if _, err := source.Open(124); err == nil {
+		t.Error("Expected an error for a missing build")
+	}
+}
+
+func TestURLSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/logs/123/build-log.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "build 123")
+	}))
+	defer server.Close()
+
+	source := &URLSource{URLTemplate: server.URL + "/logs/" + BuildPlaceholder + "/build-log.txt"}
+	checkSource(t, source, 123, "build 123")
+	if _, err := source.Open(124); err == nil {
+		t.Error("Expected an error for a missing build")
+	}
+}
+
+func TestJenkinsSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/job/scalability/123/consoleText" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "build 123")
+	}))
+	defer server.Close()
+
+	source := &JenkinsSource{Client: &jenkins.JenkinsClient{Host: server.URL}, Job: "scalability"}
+	checkSource(t, source, 123, "build 123")
+	if _, err := source.Open(124); err == nil {
+		t.Error("Expected an error for a missing build")
+	}
+}
+
+func checkSource(t *testing.T, source LogSource, buildNumber int, expected string) {
+	body, err := source.Open(buildNumber)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer body.Close()
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(content) != expected {
+		t.Errorf("Expected %q but got %q", expected, string(content))
+	}
+}
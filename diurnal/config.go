@@ -0,0 +1,330 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+	"gopkg.in/yaml.v2"
+)
+
+// invalidScheduleReason is the reason of the events about invalid schedules of the ConfigMap.
+const invalidScheduleReason = "InvalidSchedule"
+
+// retryPeriod is how long to wait before getting the ConfigMap again after an error.
+const retryPeriod = 10 * time.Second
+
+// scheduleConfig is a named schedule of the ConfigMap, whose values are YAML or JSON, e.g.
+//
+//	selector: app=web
+//	kind: Deployment
+//	timezone: America/New_York
+//	times: ["08:00", "20:00"]
+//	counts: [10, 2]
+//	profiles: ["sat,sun=00:00/2"]
+//	ramp: {percent: 20, interval: 2m}
+//	respectManual: true
+type scheduleConfig struct {
+	// Namespace of the targets, the one of the ConfigMap by default.
+	Namespace string   `yaml:"namespace"`
+	Selector  string   `yaml:"selector"`
+	Kind      string   `yaml:"kind"`
+	Mode      string   `yaml:"mode"`
+	Timezone  string   `yaml:"timezone"`
+	Times     []string `yaml:"times"`
+	Counts    []int    `yaml:"counts"`
+	Profiles  []string `yaml:"profiles"`
+	Overrides []string `yaml:"overrides"`
+	Ramp      struct {
+		Replicas int    `yaml:"replicas"`
+		Percent  int    `yaml:"percent"`
+		Interval string `yaml:"interval"`
+	} `yaml:"ramp"`
+	RespectManual bool `yaml:"respectManual"`
+}
+
+// parseScheduleConfig parses a schedule of the ConfigMap into a scaler of its targets.
+func parseScheduleConfig(client kclient.Interface, name, data, namespace string) (*scaler, error) {
+	var cfg scheduleConfig
+	if err := yaml.Unmarshal([]byte(data), &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Selector == "" {
+		return nil, fmt.Errorf("selector must be set")
+	}
+	selector, err := labels.Parse(cfg.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Namespace != "" {
+		namespace = cfg.Namespace
+	}
+	if cfg.Kind == "" {
+		cfg.Kind = kindReplicationController
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = modeReplicas
+	}
+	if err := validateKindAndMode(cfg.Kind, cfg.Mode); err != nil {
+		return nil, err
+	}
+	counts := make([]string, len(cfg.Counts))
+	for i, c := range cfg.Counts {
+		counts[i] = strconv.Itoa(c)
+	}
+	schedule, err := buildSchedule(cfg.Timezone, strings.Join(cfg.Times, ","), strings.Join(counts, ","), cfg.Profiles, cfg.Overrides)
+	if err != nil {
+		return nil, err
+	}
+	ramp, err := newRamp(cfg.Ramp.Replicas, cfg.Ramp.Percent)
+	if err != nil {
+		return nil, err
+	}
+	interval := defaultRampInterval
+	if cfg.Ramp.Interval != "" {
+		interval, err = time.ParseDuration(cfg.Ramp.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ramp interval: %v", err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("ramp interval must be positive")
+		}
+	}
+	return &scaler{
+		schedule: schedule,
+		targets: &targets{
+			client:        client,
+			namespace:     namespace,
+			kind:          cfg.Kind,
+			selector:      selector,
+			mode:          cfg.Mode,
+			schedule:      name,
+			ramp:          ramp,
+			respectManual: cfg.RespectManual,
+		},
+		interval: interval,
+	}, nil
+}
+
+// runningSchedule is a schedule of the ConfigMap that is being applied.
+type runningSchedule struct {
+	data   string
+	scaler *scaler
+}
+
+// configMapController applies the schedules of a ConfigMap, and restarts the ones that change.
+type configMapController struct {
+	client    kclient.Interface
+	namespace string
+	name      string
+	clock     clock
+
+	lock    sync.Mutex
+	running map[string]*runningSchedule
+	// invalid has the data of the invalid schedules, so an event is only created once for each.
+	invalid map[string]string
+}
+
+func newConfigMapController(client kclient.Interface, namespace, name string) *configMapController {
+	return &configMapController{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		running:   map[string]*runningSchedule{},
+		invalid:   map[string]string{},
+	}
+}
+
+// sync starts the new and changed schedules of the ConfigMap and stops the removed ones.
+// An invalid schedule keeps the previous version running, if any. A nil ConfigMap stops all.
+func (c *configMapController) sync(cm *extensions.ConfigMap) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	data := map[string]string{}
+	if cm != nil {
+		data = cm.Data
+	}
+	for name, r := range c.running {
+		if _, ok := data[name]; !ok {
+			glog.Infof("schedule %v was removed", name)
+			c.stop(name, r)
+		}
+	}
+	for name := range c.invalid {
+		if _, ok := data[name]; !ok {
+			delete(c.invalid, name)
+		}
+	}
+
+	names := []string{}
+	for name := range data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := data[name]
+		if r, ok := c.running[name]; ok && r.data == value {
+			delete(c.invalid, name)
+			continue
+		}
+		if invalid, ok := c.invalid[name]; ok && invalid == value {
+			continue
+		}
+		s, err := parseScheduleConfig(c.client, name, value, c.namespace)
+		if err != nil {
+			glog.Errorf("invalid schedule %v: %v", name, err)
+			c.invalid[name] = value
+			c.recordInvalid(cm, name, err)
+			continue
+		}
+		delete(c.invalid, name)
+		if r, ok := c.running[name]; ok {
+			glog.Infof("schedule %v changed", name)
+			c.stop(name, r)
+		}
+		s.clock = c.clock
+		glog.Infof("starting schedule %v: %v", name, s.schedule)
+		if err := s.Start(); err != nil {
+			glog.Errorf("unable to start schedule %v: %v", name, err)
+			continue
+		}
+		c.running[name] = &runningSchedule{data: value, scaler: s}
+	}
+}
+
+func (c *configMapController) stop(name string, r *runningSchedule) {
+	if err := r.scaler.Stop(); err != nil {
+		glog.Errorf("unable to stop schedule %v: %v", name, err)
+	}
+	delete(c.running, name)
+}
+
+// recordInvalid creates a warning event about an invalid schedule of the ConfigMap.
+func (c *configMapController) recordInvalid(cm *extensions.ConfigMap, name string, err error) {
+	now := unversioned.Now()
+	event := &api.Event{
+		ObjectMeta: api.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", cm.Name, now.UnixNano()),
+			Namespace: cm.Namespace,
+		},
+		InvolvedObject: api.ObjectReference{
+			Kind:            "ConfigMap",
+			Namespace:       cm.Namespace,
+			Name:            cm.Name,
+			UID:             cm.UID,
+			APIVersion:      "extensions/v1beta1",
+			ResourceVersion: cm.ResourceVersion,
+		},
+		Reason:         invalidScheduleReason,
+		Message:        fmt.Sprintf("schedule %v: %v", name, err),
+		Source:         api.EventSource{Component: "diurnal"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Type:           api.EventTypeWarning,
+	}
+	if _, err := c.client.Events(cm.Namespace).Create(event); err != nil {
+		glog.Errorf("unable to create event: %v", err)
+	}
+}
+
+// Run applies the schedules of the ConfigMap and follows its changes until stop is closed.
+func (c *configMapController) Run(stop <-chan struct{}) {
+	defer c.sync(nil)
+	for {
+		w, err := c.getAndWatch()
+		if err != nil {
+			glog.Errorf("unable to watch config map %v: %v", c.name, err)
+		} else if c.watch(w, stop) {
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(retryPeriod):
+		}
+	}
+}
+
+// getAndWatch syncs the current ConfigMap and watches it from its version.
+func (c *configMapController) getAndWatch() (watch.Interface, error) {
+	configMaps := c.client.Extensions().ConfigMaps(c.namespace)
+	cm, err := configMaps.Get(c.name)
+	resourceVersion := ""
+	switch {
+	case errors.IsNotFound(err):
+		glog.Warningf("config map %v not found", c.name)
+		c.sync(nil)
+	case err != nil:
+		return nil, err
+	default:
+		c.sync(cm)
+		resourceVersion = cm.ResourceVersion
+	}
+	return configMaps.Watch(api.ListOptions{
+		LabelSelector:   labels.Everything(),
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", c.name),
+		ResourceVersion: resourceVersion,
+	})
+}
+
+// watch syncs the changes of the ConfigMap until the watch ends. It returns whether stop was closed.
+func (c *configMapController) watch(w watch.Interface, stop <-chan struct{}) bool {
+	defer w.Stop()
+	for {
+		select {
+		case <-stop:
+			return true
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return false
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				cm, ok := event.Object.(*extensions.ConfigMap)
+				if !ok {
+					glog.Errorf("unexpected object in watch of config map %v: %v", c.name, reflect.TypeOf(event.Object))
+					return false
+				}
+				c.sync(cm)
+			case watch.Deleted:
+				glog.Warningf("config map %v was deleted", c.name)
+				c.sync(nil)
+			case watch.Error:
+				glog.Errorf("error watching config map %v: %v", c.name, event.Object)
+				return false
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+const webSchedule = `
+selector: app=web
+kind: Deployment
+timezone: America/New_York
+times: ["08:00", "20:00"]
+counts: [10, 2]
+ramp: {percent: 20, interval: 2m}
+respectManual: true
+`
+
+func scheduleConfigMap(resourceVersion string, data map[string]string) *extensions.ConfigMap {
+	return &extensions.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "schedules", Namespace: "default", ResourceVersion: resourceVersion},
+		Data:       data,
+	}
+}
+
+// fakeConfigMapClient returns a fake client that serves the config map and records the created events.
+func fakeConfigMapClient(cm *extensions.ConfigMap, w watch.Interface) (*testclient.Fake, *[]*api.Event) {
+	fake := &testclient.Fake{}
+	events := &[]*api.Event{}
+	fake.AddReactor("*", "*", func(action testclient.Action) (bool, runtime.Object, error) {
+		switch {
+		case action.GetVerb() == "get" && action.GetResource() == "configmaps":
+			return true, cm, nil
+		case action.GetVerb() == "create" && action.GetResource() == "events":
+			event := action.(testclient.CreateAction).GetObject().(*api.Event)
+			*events = append(*events, event)
+			return true, event, nil
+		}
+		return false, nil, nil
+	})
+	fake.AddWatchReactor("*", testclient.DefaultWatchReactor(w, nil))
+	return fake, events
+}
+
+func TestParseScheduleConfig(t *testing.T) {
+	s, err := parseScheduleConfig(nil, "web", webSchedule, "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	targets := s.targets.(*targets)
+	if targets.namespace != "default" || targets.kind != kindDeployment || targets.mode != modeReplicas || targets.schedule != "web" {
+		t.Errorf("unexpected targets %+v", targets)
+	}
+	if targets.ramp != (ramp{percent: 20}) || !targets.respectManual || s.interval != 2*time.Minute {
+		t.Errorf("unexpected ramp %+v every %v, respecting manual changes %v", targets.ramp, s.interval, targets.respectManual)
+	}
+	if s.schedule.location.String() != "America/New_York" || s.schedule.String() == "" {
+		t.Errorf("unexpected schedule %v", s.schedule)
+	}
+
+	invalid := []string{
+		"selector: [",
+		"times: [\"08:00\"]\ncounts: [1]",
+		"selector: app=web\ntimes: [\"08:00\"]\ncounts: [1, 2]",
+		"selector: app=web\nkind: Pod\ntimes: [\"08:00\"]\ncounts: [1]",
+		"selector: app=web\ntimezone: Nowhere/Else\ntimes: [\"08:00\"]\ncounts: [1]",
+		"selector: app=web\ntimes: [\"08:00\"]\ncounts: [-1]",
+		"selector: app=web\ntimes: [\"08:00\"]\ncounts: [1]\nramp: {percent: -5}",
+		"selector: app=web\ntimes: [\"08:00\"]\ncounts: [1]\nramp: {interval: soon}",
+		"selector: app=web\ntimes: [\"08:00\"]\ncounts: [1]\nramp: {interval: 0s}",
+	}
+	for i, data := range invalid {
+		if _, err := parseScheduleConfig(nil, "web", data, "default"); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
+
+func TestConfigMapControllerSync(t *testing.T) {
+	fake, events := fakeConfigMapClient(nil, watch.NewFake())
+	c := newConfigMapController(fake, "default", "schedules")
+	c.clock = &fakeClock{now: time.Date(2016, 1, 4, 12, 0, 0, 0, time.UTC)}
+
+	other := "selector: app=other\ntimes: [\"00:00\"]\ncounts: [1]"
+	c.sync(scheduleConfigMap("1", map[string]string{"web": webSchedule, "other": other}))
+	if len(c.running) != 2 {
+		t.Fatalf("expected 2 running schedules, got %v", c.running)
+	}
+	web := c.running["web"].scaler
+
+	// an unchanged schedule keeps running, a changed one is restarted, an invalid one is reported once
+	changed := "selector: app=other\ntimes: [\"00:00\"]\ncounts: [2]"
+	c.sync(scheduleConfigMap("2", map[string]string{"web": webSchedule, "other": changed, "bad": "selector: app=bad"}))
+	c.sync(scheduleConfigMap("3", map[string]string{"web": webSchedule, "other": changed, "bad": "selector: app=bad"}))
+	if c.running["web"].scaler != web {
+		t.Errorf("expected the unchanged schedule to keep running")
+	}
+	if c.running["other"].data != changed {
+		t.Errorf("expected the changed schedule to be restarted")
+	}
+	if _, ok := c.running["bad"]; ok {
+		t.Errorf("expected the invalid schedule not to run")
+	}
+	if len(*events) != 1 || (*events)[0].Reason != invalidScheduleReason || (*events)[0].InvolvedObject.Name != "schedules" {
+		t.Errorf("expected a single %v event, got %v", invalidScheduleReason, *events)
+	}
+
+	c.sync(scheduleConfigMap("4", map[string]string{"other": changed}))
+	if _, ok := c.running["web"]; ok || len(c.running) != 1 {
+		t.Errorf("expected the removed schedule to be stopped, got %v", c.running)
+	}
+	if _, ok := c.invalid["bad"]; ok {
+		t.Errorf("expected the removed invalid schedule to be forgotten")
+	}
+	c.sync(nil)
+	if len(c.running) != 0 {
+		t.Errorf("expected all schedules to be stopped, got %v", c.running)
+	}
+}
+
+func TestConfigMapControllerRun(t *testing.T) {
+	w := watch.NewFake()
+	fake, _ := fakeConfigMapClient(scheduleConfigMap("1", map[string]string{"web": webSchedule}), w)
+	c := newConfigMapController(fake, "default", "schedules")
+	c.clock = &fakeClock{now: time.Date(2016, 1, 4, 12, 0, 0, 0, time.UTC)}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		c.Run(stop)
+		close(done)
+	}()
+	w.Modify(scheduleConfigMap("2", map[string]string{"web": webSchedule, "other": "selector: app=other\ntimes: [\"00:00\"]\ncounts: [1]"}))
+	w.Delete(scheduleConfigMap("3", nil))
+	// a sent event was received, so the previous one was synced
+	w.Modify(scheduleConfigMap("4", map[string]string{"web": webSchedule}))
+	close(stop)
+	<-done
+	if len(c.running) != 0 {
+		t.Errorf("expected the schedules to be stopped, got %v", c.running)
+	}
+}
+
+func TestSetCountRecordsAppliedState(t *testing.T) {
+	rcs := &api.ReplicationControllerList{Items: []api.ReplicationController{{ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "default"}}}}
+	targets, updated := fakeTargets(kindReplicationController, modeReplicas, rcs)
+	targets.schedule = "web"
+	if _, err := targets.setCount(4, testNow, testNow, testNow); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*updated) != 2 {
+		t.Fatalf("expected the replication controller to be scaled and annotated, got %v", *updated)
+	}
+	var state appliedState
+	value := (*updated)[1].(*api.ReplicationController).Annotations[lastAppliedAnnotation]
+	if err := json.Unmarshal([]byte(value), &state); err != nil {
+		t.Fatalf("unable to parse %v annotation %q: %v", lastAppliedAnnotation, value, err)
+	}
+	if state.Schedule != "web" || state.Count != 4 || state.Mode != modeReplicas {
+		t.Errorf("unexpected applied state %+v", state)
+	}
+}
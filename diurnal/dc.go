@@ -31,9 +31,7 @@ import (
 	"syscall"
 	"time"
 
-	"k8s.io/kubernetes/pkg/api"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
-	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 
 	"github.com/golang/glog"
@@ -51,11 +49,11 @@ func (tc timeCount) String() string {
 	m := (tc.time % time.Hour) / time.Minute
 	s := (tc.time % time.Minute) / time.Second
 	if m == 0 && s == 0 {
-		return fmt.Sprintf("(%02dZ, %d)", h, tc.count)
+		return fmt.Sprintf("(%02d, %d)", h, tc.count)
 	} else if s == 0 {
-		return fmt.Sprintf("(%02d:%02dZ, %d)", h, m, tc.count)
+		return fmt.Sprintf("(%02d:%02d, %d)", h, m, tc.count)
 	}
-	return fmt.Sprintf("(%02d:%02d:%02dZ, %d)", h, m, s, tc.count)
+	return fmt.Sprintf("(%02d:%02d:%02d, %d)", h, m, s, tc.count)
 }
 
 type byTime []timeCount
@@ -84,6 +82,11 @@ func parseTimeRelative(s string) (time.Duration, error) {
 }
 
 func parseTimeCounts(times string, counts string) ([]timeCount, error) {
+	return parseTimeCountsWith(times, counts, parseTimeRelative)
+}
+
+// parseTimeCountsWith parses the times with parseTime, which returns their offsets from the start of the day.
+func parseTimeCountsWith(times string, counts string, parseTime func(string) (time.Duration, error)) ([]timeCount, error) {
 	ts := strings.Split(times, ",")
 	cs := strings.Split(counts, ",")
 	if len(ts) != len(cs) {
@@ -91,7 +94,7 @@ func parseTimeCounts(times string, counts string) ([]timeCount, error) {
 	}
 	var tc []timeCount
 	for i := range ts {
-		t, err := parseTimeRelative(ts[i])
+		t, err := parseTime(ts[i])
 		if err != nil {
 			return nil, err
 		}
@@ -108,84 +111,88 @@ func parseTimeCounts(times string, counts string) ([]timeCount, error) {
 	return tc, nil
 }
 
-type scaler struct {
-	timeCounts []timeCount
-	selector   labels.Selector
-	start      time.Time
-	pos        int
-	done       chan struct{}
+// countSetter applies a replica count to the scaled objects.
+type countSetter interface {
+	// setCount moves the objects towards the count, and returns whether they all reached it.
+	// from is the time of the point of the schedule with the count, until the time of the next
+	// one.
+	setCount(count int, from, now, until time.Time) (bool, error)
 }
 
-func findPos(tc []timeCount, cur int, offset time.Duration) int {
-	first := true
-	for i := cur; i != cur || first; i = (i + 1) % len(tc) {
-		if tc[i].time > offset {
-			return i
-		}
-		first = false
-	}
-	return 0
+// maxWait is the longest the scaler waits before checking the time again, so changes of the
+// wall clock are noticed.
+const maxWait = time.Minute
+
+type scaler struct {
+	schedule *schedule
+	targets  countSetter
+	clock    clock
+	// interval is how often the count is set again until the targets reach it, e.g. when
+	// they are ramped or paused.
+	interval time.Duration
+	done     chan struct{}
 }
 
-func (s *scaler) setCount(c int) {
+// setCount sets the count and returns whether the targets reached it.
+func (s *scaler) setCount(c int, from, now, until time.Time) bool {
 	glog.Infof("scaling to %d replicas", c)
-	rcList, err := client.ReplicationControllers(namespace).List(api.ListOptions{
-		LabelSelector: s.selector,
-		FieldSelector: fields.Everything(),
-	})
+	reached, err := s.targets.setCount(c, from, now, until)
 	if err != nil {
-		glog.Errorf("could not get replication controllers: %v", err)
-		return
+		glog.Errorf("unable to scale: %v", err)
 	}
-	for _, rc := range rcList.Items {
-		rc.Spec.Replicas = c
-		if _, err = client.ReplicationControllers(namespace).Update(&rc); err != nil {
-			glog.Errorf("unable to scale replication controller: %v", err)
-		}
-	}
-}
-
-func (s *scaler) timeOffset() time.Duration {
-	return time.Since(s.start) % dayPeriod
+	return reached
 }
 
-func (s *scaler) curpos(offset time.Duration) int {
-	return findPos(s.timeCounts, s.pos, offset)
-}
-
-func (s *scaler) scale() {
+// scale follows the schedule from start, when count was set.
+func (s *scaler) scale(start time.Time, count int, reached bool) {
+	from, _ := s.schedule.last(start)
+	next, _ := s.schedule.next(start)
+	retry := start.Add(s.interval)
 	for {
+		now := s.clock.Now()
+		if !now.Before(next) {
+			// the clock may have passed several points, e.g. after a suspend
+			from, count = s.schedule.last(now)
+			next, _ = s.schedule.next(now)
+			reached = s.setCount(count, from, now, next)
+			retry = now.Add(s.interval)
+			continue
+		}
+		if !reached && !now.Before(retry) {
+			reached = s.setCount(count, from, now, next)
+			retry = now.Add(s.interval)
+			continue
+		}
+		wait := next.Sub(now)
+		if !reached && retry.Sub(now) < wait {
+			wait = retry.Sub(now)
+		}
+		if wait > maxWait {
+			wait = maxWait
+		}
 		select {
 		case <-s.done:
 			return
-		default:
-			offset := s.timeOffset()
-			s.pos = s.curpos(offset)
-			if s.timeCounts[s.pos].time < offset {
-				time.Sleep(dayPeriod - offset)
-				continue
-			}
-			time.Sleep(s.timeCounts[s.pos].time - offset)
-			s.setCount(s.timeCounts[s.pos].count)
+		case <-s.clock.After(wait):
 		}
 	}
 }
 
 func (s *scaler) Start() error {
-	now := time.Now().UTC()
-	s.start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	if *startNow {
-		s.start = now
+	if s.clock == nil {
+		s.clock = realClock{}
+	}
+	if s.interval <= 0 {
+		s.interval = defaultRampInterval
 	}
-
 	// set initial count
-	pos := s.curpos(s.timeOffset())
-	// add the len to avoid getting a negative index
-	pos = (pos - 1 + len(s.timeCounts)) % len(s.timeCounts)
-	s.setCount(s.timeCounts[pos].count)
+	now := s.clock.Now()
+	from, count := s.schedule.last(now)
+	next, _ := s.schedule.next(now)
+	reached := s.setCount(count, from, now, next)
 
 	s.done = make(chan struct{})
-	go s.scale()
+	go s.scale(now, count, reached)
 	return nil
 }
 
@@ -208,9 +215,15 @@ func (s *scaler) Stop() error {
 
 var (
 	counts     = flag.String("counts", "", "replica counts, must have at least one (csv)")
-	times      = flag.String("times", "", "times to set replica counts relative to UTC following ISO 8601 (csv)")
-	userLabels = flag.String("labels", "", "replication controller labels, syntax should follow https://godoc.org/k8s.io/kubernetes/pkg/labels#Parse")
-	startNow   = flag.Bool("now", false, "times are relative to now not 0:00 UTC (for demos)")
+	times      = flag.String("times", "", "times to set replica counts following ISO 8601 (csv), relative to UTC or, if -timezone is set, wall-clock times in that time zone")
+	timezone   = flag.String("timezone", "", "IANA time zone of the times, e.g. Europe/Berlin. Daylight saving time changes are followed")
+	profiles   stringList
+	overrides  stringList
+	userLabels = flag.String("labels", "", "labels of the scaled objects, syntax should follow https://godoc.org/k8s.io/kubernetes/pkg/labels#Parse")
+	kind       = flag.String("kind", kindReplicationController, "kind of the scaled objects: ReplicationController, Deployment or ReplicaSet")
+	mode       = flag.String("mode", modeReplicas, "how counts are applied: replicas sets the replicas of the objects, hpa-min and hpa-max set the minimum or maximum replicas of their HorizontalPodAutoscalers. The "+modeAnnotation+" annotation of an object overrides it")
+	configMap  = flag.String("config-map", "", "name of a ConfigMap in POD_NAMESPACE with named schedules, which are applied instead of the ones of the flags and reloaded when it changes")
+	startNow   = flag.Bool("now", false, "times are relative to now not 0:00 UTC (for demos), can't be used with -timezone")
 	local      = flag.Bool("local", false, "set to true if running on local machine not within cluster")
 	localPort  = flag.Int("localport", 8001, "port that kubectl proxy is running on (local must be true)")
 
@@ -219,10 +232,22 @@ var (
 	client *kclient.Client
 )
 
+// Flags of the gradual scaling of targets.
+var (
+	rampReplicas  = flag.Int("ramp-replicas", 0, "largest change of the count of a target at once, 0 for no limit")
+	rampPercent   = flag.Int("ramp-percent", 0, "largest change of the count of a target at once, in percent of its count, 0 for no limit")
+	rampInterval  = flag.Duration("ramp-interval", defaultRampInterval, "interval between the steps of a ramp")
+	respectManual = flag.Bool("respect-manual", false, "pause the schedule of targets scaled by something other than diurnal until the next point of the schedule, or until their "+pausedUntilAnnotation+" annotation is removed")
+)
+
 const usageNotes = `
 counts and times must both be set and be of equal length. Example usage:
   diurnal -labels name=redis-slave -times 00:00:00Z,06:00:00Z -counts 3,9
   diurnal -labels name=redis-slave -times 0600-0500,0900-0500,1700-0500,2200-0500 -counts 15,20,13,6
+  diurnal -kind Deployment -mode hpa-min -labels app=frontend -times 08:00Z,20:00Z -counts 10,2
+  diurnal -labels name=web -timezone America/New_York -times 08:00,20:00 -counts 10,2 -profile sat,sun=00:00/2 -override 2016-12-25=00:00/1
+  diurnal -kind Deployment -labels app=api -times 08:00Z,20:00Z -counts 40,10 -ramp-percent 20 -ramp-interval 2m -respect-manual
+  diurnal -config-map diurnal-schedules
 `
 
 func usage() {
@@ -231,6 +256,11 @@ func usage() {
 	fmt.Fprint(os.Stderr, usageNotes)
 }
 
+func init() {
+	flag.Var(&profiles, "profile", "time counts of some days of the week, overriding -times and -counts on those days, e.g. sat,sun=08:00/5,20:00/2 (can be repeated)")
+	flag.Var(&overrides, "override", "time counts of a date, e.g. a holiday, overriding the ones of its day of the week, e.g. 2016-12-25=00:00/1 (can be repeated)")
+}
+
 func main() {
 	flag.Usage = usage
 	flag.Parse()
@@ -250,30 +280,57 @@ func main() {
 		}
 	}
 	client, err = kclient.New(cfg)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	if namespace == "" {
+		glog.Fatal("POD_NAMESPACE is not set. Set to the namespace of the scaled objects if running locally.")
+	}
+	if *configMap != "" {
+		runConfigMap(*configMap)
+		return
+	}
 
 	selector, err := labels.Parse(*userLabels)
 	if err != nil {
 		glog.Fatal(err)
 	}
-	tc, err := parseTimeCounts(*times, *counts)
+	if *startNow && *timezone != "" {
+		glog.Fatal("-now can't be used with -timezone")
+	}
+	schedule, err := buildSchedule(*timezone, *times, *counts, profiles, overrides)
 	if err != nil {
 		glog.Fatal(err)
 	}
-	if namespace == "" {
-		glog.Fatal("POD_NAMESPACE is not set. Set to the namespace of the replication controller if running locally.")
+	if *startNow {
+		// Move midnight of the schedule to now.
+		now := time.Now().UTC()
+		sinceMidnight := now.Sub(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+		schedule.location = time.FixedZone("now", -int(sinceMidnight/time.Second))
+	}
+	glog.Infof("schedule: %v", schedule)
+	if err := validateKindAndMode(*kind, *mode); err != nil {
+		glog.Fatal(err)
 	}
-	scaler := scaler{timeCounts: tc, selector: selector}
+	ramp, err := newRamp(*rampReplicas, *rampPercent)
 	if err != nil {
 		glog.Fatal(err)
 	}
+	scaler := scaler{
+		schedule: schedule,
+		targets: &targets{
+			client:        client,
+			namespace:     namespace,
+			kind:          *kind,
+			selector:      selector,
+			mode:          *mode,
+			ramp:          ramp,
+			respectManual: *respectManual,
+		},
+		interval: *rampInterval,
+	}
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan,
-		syscall.SIGHUP,
-		syscall.SIGINT,
-		syscall.SIGQUIT,
-		syscall.SIGTERM)
-
+	sigChan := notifySignals()
 	glog.Info("starting scaling")
 	if err := scaler.Start(); err != nil {
 		glog.Fatal(err)
@@ -284,3 +341,30 @@ func main() {
 		glog.Fatal(err)
 	}
 }
+
+func notifySignals() <-chan os.Signal {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGQUIT,
+		syscall.SIGTERM)
+	return sigChan
+}
+
+// runConfigMap applies the schedules of the ConfigMap until a signal is received.
+func runConfigMap(name string) {
+	sigChan := notifySignals()
+	controller := newConfigMapController(client, namespace, name)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	glog.Infof("starting scaling with the schedules of config map %v", name)
+	go func() {
+		controller.Run(stop)
+		close(done)
+	}()
+	<-sigChan
+	glog.Info("stopping scaling")
+	close(stop)
+	<-done
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// defaultRampInterval is how often targets that haven't reached the count of the schedule are
+// scaled again, when no interval is given.
+const defaultRampInterval = time.Minute
+
+// ramp limits how much the count of a target changes at once. When both limits are set, the
+// smaller one applies.
+type ramp struct {
+	// replicas is the largest change, 0 for no limit.
+	replicas int
+	// percent is the largest change in percent of the current count, at least one replica,
+	// 0 for no limit.
+	percent int
+}
+
+func newRamp(replicas, percent int) (ramp, error) {
+	if replicas < 0 {
+		return ramp{}, fmt.Errorf("ramp replicas must be non-negative")
+	}
+	if percent < 0 {
+		return ramp{}, fmt.Errorf("ramp percent must be non-negative")
+	}
+	return ramp{replicas: replicas, percent: percent}, nil
+}
+
+// next returns the count after one step from current towards count.
+func (r ramp) next(current, count int) int {
+	step := r.replicas
+	if r.percent > 0 {
+		// round up, so a small target still moves
+		p := (current*r.percent + 99) / 100
+		if p < 1 {
+			p = 1
+		}
+		if step == 0 || p < step {
+			step = p
+		}
+	}
+	switch {
+	case step == 0:
+		return count
+	case count > current+step:
+		return current + step
+	case count < current-step:
+		return current - step
+	}
+	return count
+}
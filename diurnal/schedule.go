@@ -0,0 +1,272 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// dateLayout is the layout of the dates of the overrides.
+const dateLayout = "2006-01-02"
+
+// schedule has the replica counts for the wall-clock times of each day in a location. The
+// times of a timeCount are offsets from midnight in the location.
+type schedule struct {
+	location *time.Location
+	// weekdays has the time counts of each day of the week, indexed by time.Weekday.
+	weekdays [7][]timeCount
+	// dates overrides the time counts of specific dates, e.g. holidays, keyed by dateLayout.
+	dates map[string][]timeCount
+}
+
+// newSchedule creates a schedule that uses the same time counts every day.
+func newSchedule(location *time.Location, tc []timeCount) *schedule {
+	s := &schedule{location: location, dates: map[string][]timeCount{}}
+	for i := range s.weekdays {
+		s.weekdays[i] = tc
+	}
+	return s
+}
+
+// timeCountsOn returns the time counts of the day of t in the location of the schedule.
+func (s *schedule) timeCountsOn(t time.Time) []timeCount {
+	t = t.In(s.location)
+	if tc, ok := s.dates[t.Format(dateLayout)]; ok {
+		return tc
+	}
+	return s.weekdays[t.Weekday()]
+}
+
+// at returns the instant of the wall-clock time tc on the day of day. Times that don't exist
+// because of a daylight saving change are moved forward by the length of the change.
+func (s *schedule) at(day time.Time, tc timeCount) time.Time {
+	y, m, d := day.In(s.location).Date()
+	h := int(tc.time / time.Hour)
+	min := int(tc.time % time.Hour / time.Minute)
+	sec := int(tc.time % time.Minute / time.Second)
+	t := time.Date(y, m, d, h, min, sec, 0, s.location)
+	if t.Hour() == h && t.Minute() == min {
+		return t
+	}
+	// The time is in the gap of a daylight saving change, time.Date may move it either way.
+	// Use the offset before the change so the time is moved forward.
+	_, offset := t.Add(-6 * time.Hour).Zone()
+	return time.Date(y, m, d, h, min, sec, 0, time.UTC).Add(-time.Duration(offset) * time.Second).In(s.location)
+}
+
+// startOfDay returns the midnight of the day of t in the location of the schedule.
+func (s *schedule) startOfDay(t time.Time) time.Time {
+	y, m, d := t.In(s.location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, s.location)
+}
+
+// next returns the time of the first point of the schedule after now and its count.
+func (s *schedule) next(now time.Time) (time.Time, int) {
+	day := s.startOfDay(now)
+	tc := s.timeCountsOn(day)
+	for i := range tc {
+		if t := s.at(day, tc[i]); t.After(now) {
+			return t, tc[i].count
+		}
+	}
+	// The day doesn't have more points, the next one is the first of the next day. Noon is
+	// used to move to the next day as days aren't always 24 hours long.
+	tomorrow := s.startOfDay(day.Add(36 * time.Hour))
+	tc = s.timeCountsOn(tomorrow)
+	return s.at(tomorrow, tc[0]), tc[0].count
+}
+
+// current returns the count in effect at now, the one of the last point of the schedule
+// before or at now.
+func (s *schedule) current(now time.Time) int {
+	_, count := s.last(now)
+	return count
+}
+
+// last returns the time of the last point of the schedule before or at now and its count.
+func (s *schedule) last(now time.Time) (time.Time, int) {
+	day := s.startOfDay(now)
+	tc := s.timeCountsOn(day)
+	for i := len(tc) - 1; i >= 0; i-- {
+		if t := s.at(day, tc[i]); !t.After(now) {
+			return t, tc[i].count
+		}
+	}
+	yesterday := s.startOfDay(day.Add(-12 * time.Hour))
+	tc = s.timeCountsOn(yesterday)
+	return s.at(yesterday, tc[len(tc)-1]), tc[len(tc)-1].count
+}
+
+// parseWallTime parses a time of day without offset, e.g. 08:30, as an offset from midnight.
+func parseWallTime(s string) (time.Duration, error) {
+	if strings.ContainsAny(s, "Z+-") {
+		return 0, fmt.Errorf("unable to parse %s: times can't have an offset when a time zone is given", s)
+	}
+	t, err := parseTimeISO8601(s)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse %s: %v", s, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
+}
+
+// parsePairs parses a list of time/count pairs, e.g. 08:00/5,20:00/2.
+func parsePairs(s string, parseTime func(string) (time.Duration, error)) ([]timeCount, error) {
+	var times, counts []string
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.Split(pair, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected time/count, got %q", pair)
+		}
+		times = append(times, parts[0])
+		counts = append(counts, parts[1])
+	}
+	return parseTimeCountsWith(strings.Join(times, ","), strings.Join(counts, ","), parseTime)
+}
+
+// parseProfile parses the time counts of some days of the week, e.g. sat,sun=08:00/5,20:00/2.
+func parseProfile(s string, parseTime func(string) (time.Duration, error)) ([]time.Weekday, []timeCount, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 {
+		return nil, nil, fmt.Errorf("expected days=time/count,..., got %q", s)
+	}
+	var days []time.Weekday
+	for _, name := range strings.Split(parts[0], ",") {
+		day, err := parseWeekday(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		days = append(days, day)
+	}
+	tc, err := parsePairs(parts[1], parseTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	return days, tc, nil
+}
+
+// parseOverride parses the time counts of a date, e.g. 2016-12-25=00:00/1.
+func parseOverride(s string, parseTime func(string) (time.Duration, error)) (string, []timeCount, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 {
+		return "", nil, fmt.Errorf("expected date=time/count,..., got %q", s)
+	}
+	date, err := time.Parse(dateLayout, parts[0])
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to parse date %q, expected YYYY-MM-DD", parts[0])
+	}
+	tc, err := parsePairs(parts[1], parseTime)
+	if err != nil {
+		return "", nil, err
+	}
+	return date.Format(dateLayout), tc, nil
+}
+
+// parseWeekday parses the name of a day of the week, e.g. "Saturday" or "sat".
+func parseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown day of the week %q", name)
+}
+
+// buildSchedule creates a schedule from the default time counts, the profiles of days of the
+// week and the date overrides. If zone is empty, times are relative to UTC and may have offsets,
+// otherwise they are wall-clock times in the IANA time zone.
+func buildSchedule(zone, times, counts string, profiles, overrides []string) (*schedule, error) {
+	location := time.UTC
+	parseTime := parseTimeRelative
+	if zone != "" {
+		var err error
+		if location, err = time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %v", zone, err)
+		}
+		parseTime = parseWallTime
+	}
+	tc, err := parseTimeCountsWith(times, counts, parseTime)
+	if err != nil {
+		return nil, err
+	}
+	s := newSchedule(location, tc)
+	for _, p := range profiles {
+		days, tc, err := parseProfile(p, parseTime)
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range days {
+			s.weekdays[day] = tc
+		}
+	}
+	for _, o := range overrides {
+		date, tc, err := parseOverride(o, parseTime)
+		if err != nil {
+			return nil, err
+		}
+		s.dates[date] = tc
+	}
+	return s, nil
+}
+
+// String lists the time counts of the schedule.
+func (s *schedule) String() string {
+	parts := []string{fmt.Sprintf("location %v", s.location)}
+	for day, tc := range s.weekdays {
+		parts = append(parts, fmt.Sprintf("%v %v", time.Weekday(day), tc))
+	}
+	dates := []string{}
+	for date := range s.dates {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	for _, date := range dates {
+		parts = append(parts, fmt.Sprintf("%v %v", date, s.dates[date]))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// clock tells the time, it is faked in tests.
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// stringList is a flag that can be given several times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, " ")
+}
+
+func (l *stringList) Set(s string) error {
+	if s == "" {
+		return errors.New("empty value")
+	}
+	*l = append(*l, s)
+	return nil
+}
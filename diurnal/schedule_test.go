@@ -0,0 +1,299 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeWaiter struct {
+	at time.Time
+	c  chan time.Time
+}
+
+// fakeClock is a clock that only moves when Step is called.
+type fakeClock struct {
+	lock    sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	c := make(chan time.Time, 1)
+	f.waiters = append(f.waiters, fakeWaiter{f.now.Add(d), c})
+	return c
+}
+
+// Step moves the clock and fires the waiters whose time passed.
+func (f *fakeClock) Step(d time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.now = f.now.Add(d)
+	waiters := []fakeWaiter{}
+	for _, w := range f.waiters {
+		if w.at.After(f.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		w.c <- f.now
+	}
+	f.waiters = waiters
+}
+
+// fakeCounts records the counts set by the scaler.
+type fakeCounts chan int
+
+func (f fakeCounts) setCount(count int, from, now, until time.Time) (bool, error) {
+	f <- count
+	return true, nil
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("unable to load %v: %v", name, err)
+	}
+	return location
+}
+
+func TestScheduleNext(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	s, err := buildSchedule("America/New_York", "02:30,08:00,20:00", "5,10,2", []string{"sat,sun=12:00/4"}, []string{"2016-12-26=00:00/1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cases := []struct {
+		now   time.Time
+		next  time.Time
+		count int
+	}{
+		{time.Date(2016, 3, 10, 7, 0, 0, 0, ny), time.Date(2016, 3, 10, 8, 0, 0, 0, ny), 10},
+		{time.Date(2016, 3, 10, 8, 0, 0, 0, ny), time.Date(2016, 3, 10, 20, 0, 0, 0, ny), 2},
+		{time.Date(2016, 3, 10, 21, 0, 0, 0, ny), time.Date(2016, 3, 11, 2, 30, 0, 0, ny), 5},
+		// the weekend profile
+		{time.Date(2016, 3, 11, 21, 0, 0, 0, ny), time.Date(2016, 3, 12, 12, 0, 0, 0, ny), 4},
+		{time.Date(2016, 12, 25, 13, 0, 0, 0, ny), time.Date(2016, 12, 26, 0, 0, 0, 0, ny), 1},
+		// the override of the date
+		{time.Date(2016, 12, 26, 0, 0, 0, 0, ny), time.Date(2016, 12, 27, 2, 30, 0, 0, ny), 5},
+		{time.Date(2016, 3, 13, 23, 0, 0, 0, ny), time.Date(2016, 3, 14, 2, 30, 0, 0, ny), 5},
+		{time.Date(2016, 11, 6, 12, 30, 0, 0, ny), time.Date(2016, 11, 7, 2, 30, 0, 0, ny), 5},
+	}
+	for i, test := range cases {
+		next, count := s.next(test.now)
+		if !next.Equal(test.next) || count != test.count {
+			t.Errorf("case %d: expected %v at %v, got %v at %v", i, test.count, test.next, count, next)
+		}
+	}
+}
+
+func TestScheduleNextDaylightSaving(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	s, err := buildSchedule("America/New_York", "02:30,08:00", "5,10", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 02:30 doesn't exist on 2016-03-13, the clock moves from 02:00 EST to 03:00 EDT
+	next, _ := s.next(time.Date(2016, 3, 13, 1, 0, 0, 0, ny))
+	if expected := time.Date(2016, 3, 13, 7, 30, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, next)
+	}
+	// 08:00 EDT is 12:00 UTC, a day before it was 13:00 UTC
+	next, _ = s.next(time.Date(2016, 3, 13, 4, 0, 0, 0, ny))
+	if expected := time.Date(2016, 3, 13, 12, 0, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, next)
+	}
+	next, _ = s.next(time.Date(2016, 11, 6, 4, 0, 0, 0, ny))
+	if expected := time.Date(2016, 11, 6, 13, 0, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, next)
+	}
+}
+
+func TestScheduleCurrent(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	s, err := buildSchedule("America/New_York", "08:00,20:00", "10,2", []string{"Saturday,Sunday=12:00/4"}, []string{"2016-12-26=00:00/1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cases := []struct {
+		now   time.Time
+		count int
+	}{
+		{time.Date(2016, 12, 22, 7, 0, 0, 0, ny), 2},
+		{time.Date(2016, 12, 22, 8, 0, 0, 0, ny), 10},
+		{time.Date(2016, 12, 24, 10, 0, 0, 0, ny), 2},
+		{time.Date(2016, 12, 24, 12, 0, 0, 0, ny), 4},
+		{time.Date(2016, 12, 26, 23, 0, 0, 0, ny), 1},
+		{time.Date(2016, 12, 27, 7, 0, 0, 0, ny), 1},
+	}
+	for i, test := range cases {
+		if count := s.current(test.now); count != test.count {
+			t.Errorf("case %d: expected %d, got %d", i, test.count, count)
+		}
+	}
+}
+
+func TestBuildScheduleErrors(t *testing.T) {
+	cases := []struct {
+		zone      string
+		times     string
+		counts    string
+		profiles  []string
+		overrides []string
+	}{
+		{"Mars/Olympus_Mons", "08:00", "1", nil, nil},
+		{"Europe/Berlin", "08:00Z", "1", nil, nil},
+		{"Europe/Berlin", "08:00+01", "1", nil, nil},
+		{"", "08:00Z", "1", []string{"sat,someday=08:00Z/1"}, nil},
+		{"", "08:00Z", "1", []string{"sat=08:00Z"}, nil},
+		{"", "08:00Z", "1", []string{"sat"}, nil},
+		{"", "08:00Z", "1", nil, []string{"12/25/2016=08:00Z/1"}},
+		{"", "08:00Z", "1", nil, []string{"2016-12-25=08:00Z/-1"}},
+	}
+	for i, test := range cases {
+		if _, err := buildSchedule(test.zone, test.times, test.counts, test.profiles, test.overrides); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
+
+func expectCount(t *testing.T, counts fakeCounts, expected int, at time.Time) {
+	select {
+	case count := <-counts:
+		if count != expected {
+			t.Errorf("expected %d replicas at %v, got %d", expected, at, count)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected %d replicas at %v", expected, at)
+	}
+}
+
+func TestScalerFollowsWallClock(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	s, err := buildSchedule("America/New_York", "08:00,20:00", "10,2", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock := &fakeClock{now: time.Date(2016, 3, 12, 9, 0, 0, 0, ny)}
+	counts := make(fakeCounts, 10)
+	sc := &scaler{schedule: s, targets: counts, clock: clock}
+	if err := sc.Start(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sc.Stop()
+	expectCount(t, counts, 10, clock.Now())
+
+	// Move the clock in steps of 10 minutes until the next day at 08:00, after the start of
+	// daylight saving time, checking the counts are set at the right times.
+	expected := map[time.Time]int{
+		time.Date(2016, 3, 12, 20, 0, 0, 0, ny): 2,
+		time.Date(2016, 3, 13, 8, 0, 0, 0, ny):  10,
+	}
+	end := time.Date(2016, 3, 13, 8, 0, 0, 0, ny)
+	for clock.Now().Before(end) {
+		clock.Step(10 * time.Minute)
+		now := clock.Now()
+		if count, ok := expected[now]; ok {
+			expectCount(t, counts, count, now)
+			continue
+		}
+		// give the scaler a chance to set a count at the wrong time
+		time.Sleep(time.Millisecond)
+		select {
+		case count := <-counts:
+			t.Fatalf("unexpected count %d at %v", count, now)
+		default:
+		}
+	}
+}
+
+func TestScalerSkipsPassedPoints(t *testing.T) {
+	s, err := buildSchedule("", "08:00Z,12:00Z,20:00Z", "10,5,2", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock := &fakeClock{now: time.Date(2016, 3, 12, 9, 0, 0, 0, time.UTC)}
+	counts := make(fakeCounts, 10)
+	sc := &scaler{schedule: s, targets: counts, clock: clock}
+	if err := sc.Start(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sc.Stop()
+	expectCount(t, counts, 10, clock.Now())
+
+	// the clock jumps over 12:00 and 20:00, the count of 20:00 applies
+	clock.Step(12 * time.Hour)
+	expectCount(t, counts, 2, clock.Now())
+	time.Sleep(time.Millisecond)
+	select {
+	case count := <-counts:
+		t.Fatalf("unexpected count %d at %v", count, clock.Now())
+	default:
+	}
+}
+
+// rampCounts records the counts set by the scaler, and reaches them after steps calls.
+type rampCounts struct {
+	fakeCounts
+	steps int
+}
+
+func (r *rampCounts) setCount(count int, from, now, until time.Time) (bool, error) {
+	r.fakeCounts <- count
+	r.steps--
+	return r.steps <= 0, nil
+}
+
+func TestScalerRetriesUntilReached(t *testing.T) {
+	s, err := buildSchedule("", "08:00Z,20:00Z", "10,2", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock := &fakeClock{now: time.Date(2016, 3, 12, 9, 0, 0, 0, time.UTC)}
+	counts := &rampCounts{fakeCounts: make(fakeCounts, 10), steps: 3}
+	sc := &scaler{schedule: s, targets: counts, clock: clock, interval: 5 * time.Minute}
+	if err := sc.Start(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sc.Stop()
+	expectCount(t, counts.fakeCounts, 10, clock.Now())
+
+	// the count is set again every interval until it is reached
+	for i := 0; i < 4; i++ {
+		clock.Step(5 * time.Minute)
+		now := clock.Now()
+		if i < 2 {
+			expectCount(t, counts.fakeCounts, 10, now)
+			continue
+		}
+		time.Sleep(time.Millisecond)
+		select {
+		case count := <-counts.fakeCounts:
+			t.Fatalf("unexpected count %d at %v", count, now)
+		default:
+		}
+	}
+}
@@ -0,0 +1,436 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	"github.com/golang/glog"
+)
+
+// Kinds of the objects whose replicas are set on the schedule.
+const (
+	kindReplicationController = "ReplicationController"
+	kindDeployment            = "Deployment"
+	kindReplicaSet            = "ReplicaSet"
+)
+
+// Modes of applying the replica count to a target.
+const (
+	// modeReplicas sets the replicas of the target.
+	modeReplicas = "replicas"
+	// modeHPAMin sets the minimum replicas of the HorizontalPodAutoscaler of the target.
+	modeHPAMin = "hpa-min"
+	// modeHPAMax sets the maximum replicas of the HorizontalPodAutoscaler of the target.
+	modeHPAMax = "hpa-max"
+)
+
+// modeAnnotation on a target overrides the mode given by flag for that target.
+const modeAnnotation = "diurnal.alpha.kubernetes.io/mode"
+
+// lastAppliedAnnotation records the last count a named schedule applied to a target.
+const lastAppliedAnnotation = "diurnal.alpha.kubernetes.io/last-applied"
+
+// pausedUntilAnnotation is set on a target that was scaled by something other than diurnal,
+// when manual changes are respected. The target isn't scaled until the time it holds, the next
+// point of the schedule, or until the annotation is removed.
+const pausedUntilAnnotation = "diurnal.alpha.kubernetes.io/paused-until"
+
+// appliedState is the value of lastAppliedAnnotation.
+type appliedState struct {
+	Schedule string `json:"schedule,omitempty"`
+	Mode     string `json:"mode"`
+	Count    int    `json:"count"`
+	Time     string `json:"time"`
+}
+
+var (
+	validKinds = []string{kindReplicationController, kindDeployment, kindReplicaSet}
+	validModes = []string{modeReplicas, modeHPAMin, modeHPAMax}
+)
+
+func validateKindAndMode(kind, mode string) error {
+	if !contains(validKinds, kind) {
+		return fmt.Errorf("unknown kind %q, must be one of %v", kind, strings.Join(validKinds, ", "))
+	}
+	if !contains(validModes, mode) {
+		return fmt.Errorf("unknown mode %q, must be one of %v", mode, strings.Join(validModes, ", "))
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// targetObject is an object matching the selector of the targets.
+type targetObject struct {
+	name        string
+	annotations map[string]string
+	replicas    int
+}
+
+// targets are the objects of a kind matching a selector in a namespace.
+type targets struct {
+	client    kclient.Interface
+	namespace string
+	kind      string
+	selector  labels.Selector
+	// mode is used for the objects without modeAnnotation
+	mode string
+	// schedule is the name of the schedule of a ConfigMap, if any. Targets of a named
+	// schedule record the last applied state in lastAppliedAnnotation.
+	schedule string
+	// ramp limits the change of the count of a target at once.
+	ramp ramp
+	// respectManual pauses the schedule of targets that were scaled by something other than
+	// diurnal, detected with lastAppliedAnnotation.
+	respectManual bool
+}
+
+func (t *targets) list() ([]targetObject, error) {
+	opts := api.ListOptions{
+		LabelSelector: t.selector,
+		FieldSelector: fields.Everything(),
+	}
+	objects := []targetObject{}
+	switch t.kind {
+	case kindReplicationController:
+		rcList, err := t.client.ReplicationControllers(t.namespace).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, rc := range rcList.Items {
+			objects = append(objects, targetObject{rc.Name, rc.Annotations, rc.Spec.Replicas})
+		}
+	case kindDeployment:
+		dList, err := t.client.Extensions().Deployments(t.namespace).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dList.Items {
+			objects = append(objects, targetObject{d.Name, d.Annotations, d.Spec.Replicas})
+		}
+	case kindReplicaSet:
+		// There is no typed client for ReplicaSets, only their metadata and replicas are needed.
+		ext, ok := t.client.Extensions().(*kclient.ExtensionsClient)
+		if !ok {
+			return nil, fmt.Errorf("listing %v requires a REST client", t.kind)
+		}
+		data, err := ext.Get().Namespace(t.namespace).Resource("replicasets").LabelsSelectorParam(t.selector).DoRaw()
+		if err != nil {
+			return nil, err
+		}
+		rsList := struct {
+			Items []struct {
+				Metadata api.ObjectMeta `json:"metadata"`
+				Spec     struct {
+					Replicas int `json:"replicas"`
+				} `json:"spec"`
+			} `json:"items"`
+		}{}
+		if err := json.Unmarshal(data, &rsList); err != nil {
+			return nil, err
+		}
+		for _, rs := range rsList.Items {
+			objects = append(objects, targetObject{rs.Metadata.Name, rs.Metadata.Annotations, rs.Spec.Replicas})
+		}
+	default:
+		return nil, fmt.Errorf("unknown kind %q", t.kind)
+	}
+	return objects, nil
+}
+
+// setCount moves all the targets towards the count, with the mode of each one, and returns
+// whether they all reached it. from is the time of the point of the schedule with the count,
+// until the time of the next one.
+func (t *targets) setCount(count int, from, now, until time.Time) (bool, error) {
+	objects, err := t.list()
+	if err != nil {
+		return false, fmt.Errorf("could not list %v: %v", t.kind, err)
+	}
+	reached := true
+	var errs []string
+	for _, obj := range objects {
+		mode := t.mode
+		if m, ok := obj.annotations[modeAnnotation]; ok {
+			if !contains(validModes, m) {
+				errs = append(errs, fmt.Sprintf("%v %v has an unknown %v annotation %q", t.kind, obj.name, modeAnnotation, m))
+				continue
+			}
+			mode = m
+		}
+		objReached, err := t.setObjectCount(obj, mode, count, from, now, until)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		reached = reached && objReached
+	}
+	if len(errs) != 0 {
+		return false, fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+	return reached, nil
+}
+
+// setObjectCount moves a target towards the count, and returns whether it reached it.
+func (t *targets) setObjectCount(obj targetObject, mode string, count int, from, now, until time.Time) (bool, error) {
+	// an autoscaler keeps at least one replica
+	if mode != modeReplicas && count < 1 {
+		count = 1
+	}
+	current, err := t.current(obj, mode)
+	if err != nil {
+		return false, err
+	}
+	annotations := map[string]string{}
+	if t.respectManual {
+		if paused, ok := obj.annotations[pausedUntilAnnotation]; ok {
+			if pausedUntil, err := time.Parse(time.RFC3339, paused); err == nil && now.Before(pausedUntil) {
+				return false, nil
+			}
+			annotations[pausedUntilAnnotation] = ""
+		} else if last, ok := lastApplied(obj); ok && last.Count != current && !last.before(from) {
+			glog.Infof("%v %v was scaled to %d instead of %d, pausing its schedule until %v", t.kind, obj.name, current, last.Count, until)
+			return false, t.annotate(obj.name, map[string]string{
+				pausedUntilAnnotation: until.UTC().Format(time.RFC3339),
+				lastAppliedAnnotation: t.appliedState(mode, current, now),
+			})
+		}
+	}
+	next := t.ramp.next(current, count)
+	if mode == modeReplicas {
+		err = t.setReplicas(obj.name, next)
+	} else {
+		err = t.setHPABound(obj.name, mode, next)
+	}
+	if err != nil {
+		return false, err
+	}
+	if t.schedule != "" || t.respectManual {
+		annotations[lastAppliedAnnotation] = t.appliedState(mode, next, now)
+	}
+	if len(annotations) != 0 {
+		if err := t.annotate(obj.name, annotations); err != nil {
+			return false, err
+		}
+	}
+	return next == count, nil
+}
+
+// current returns the count of a target, the value set by the mode.
+func (t *targets) current(obj targetObject, mode string) (int, error) {
+	if mode == modeReplicas {
+		return obj.replicas, nil
+	}
+	hpa, err := t.findHPA(obj.name)
+	if err != nil {
+		return 0, err
+	}
+	if mode == modeHPAMax {
+		return hpa.Spec.MaxReplicas, nil
+	}
+	if hpa.Spec.MinReplicas == nil {
+		return 1, nil
+	}
+	return *hpa.Spec.MinReplicas, nil
+}
+
+// lastApplied returns the state recorded in lastAppliedAnnotation of a target, if any.
+func lastApplied(obj targetObject) (appliedState, bool) {
+	var state appliedState
+	value, ok := obj.annotations[lastAppliedAnnotation]
+	if !ok {
+		return state, false
+	}
+	if err := json.Unmarshal([]byte(value), &state); err != nil {
+		glog.Warningf("ignoring invalid %v annotation of %v: %v", lastAppliedAnnotation, obj.name, err)
+		return state, false
+	}
+	return state, true
+}
+
+// before returns whether the state was applied before t. A target changed manually after a
+// state applied before the current point of the schedule isn't paused: the change was found
+// at the point, where the schedule applies again.
+func (s appliedState) before(t time.Time) bool {
+	applied, err := time.Parse(time.RFC3339, s.Time)
+	return err == nil && applied.Before(t.Truncate(time.Second))
+}
+
+func (t *targets) appliedState(mode string, count int, now time.Time) string {
+	value, err := json.Marshal(appliedState{Schedule: t.schedule, Mode: mode, Count: count, Time: now.UTC().Format(time.RFC3339)})
+	if err != nil {
+		// the fields are always valid JSON
+		panic(err)
+	}
+	return string(value)
+}
+
+// setReplicas sets the replicas of a replication controller directly, and the ones of other
+// kinds through their scale subresource.
+func (t *targets) setReplicas(name string, count int) error {
+	glog.Infof("scaling %v %v to %d replicas", t.kind, name, count)
+	if t.kind == kindReplicationController {
+		rc, err := t.client.ReplicationControllers(t.namespace).Get(name)
+		if err != nil {
+			return fmt.Errorf("unable to get replication controller %v: %v", name, err)
+		}
+		rc.Spec.Replicas = count
+		if _, err = t.client.ReplicationControllers(t.namespace).Update(rc); err != nil {
+			return fmt.Errorf("unable to scale replication controller %v: %v", name, err)
+		}
+		return nil
+	}
+	scale, err := t.client.Extensions().Scales(t.namespace).Get(t.kind, name)
+	if err != nil {
+		return fmt.Errorf("unable to get scale of %v %v: %v", t.kind, name, err)
+	}
+	scale.Spec.Replicas = count
+	if _, err = t.client.Extensions().Scales(t.namespace).Update(t.kind, scale); err != nil {
+		return fmt.Errorf("unable to scale %v %v: %v", t.kind, name, err)
+	}
+	return nil
+}
+
+// findHPA returns the HorizontalPodAutoscaler that scales the target.
+func (t *targets) findHPA(name string) (*extensions.HorizontalPodAutoscaler, error) {
+	hpaList, err := t.client.Extensions().HorizontalPodAutoscalers(t.namespace).List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get horizontal pod autoscalers: %v", err)
+	}
+	for i := range hpaList.Items {
+		hpa := &hpaList.Items[i]
+		if hpa.Spec.ScaleRef.Kind == t.kind && hpa.Spec.ScaleRef.Name == name {
+			return hpa, nil
+		}
+	}
+	return nil, fmt.Errorf("no horizontal pod autoscaler found for %v %v", t.kind, name)
+}
+
+// setHPABound sets the min or max replicas of the HorizontalPodAutoscaler that scales the target.
+// The other bound is moved if needed to keep min <= max.
+func (t *targets) setHPABound(name, mode string, count int) error {
+	hpa, err := t.findHPA(name)
+	if err != nil {
+		return err
+	}
+	min := 1
+	if hpa.Spec.MinReplicas != nil {
+		min = *hpa.Spec.MinReplicas
+	}
+	max := hpa.Spec.MaxReplicas
+	if mode == modeHPAMin {
+		min = count
+		if max < min {
+			max = min
+		}
+	} else {
+		max = count
+		if min > max {
+			min = max
+		}
+	}
+	glog.Infof("setting replicas of autoscaler %v of %v %v to %d...%d", hpa.Name, t.kind, name, min, max)
+	hpa.Spec.MinReplicas = &min
+	hpa.Spec.MaxReplicas = max
+	if _, err := t.client.Extensions().HorizontalPodAutoscalers(t.namespace).Update(hpa); err != nil {
+		return fmt.Errorf("unable to update autoscaler %v: %v", hpa.Name, err)
+	}
+	return nil
+}
+
+// annotate sets the annotations of a target. Empty values remove annotations.
+func (t *targets) annotate(name string, annotations map[string]string) error {
+	switch t.kind {
+	case kindReplicationController:
+		rc, err := t.client.ReplicationControllers(t.namespace).Get(name)
+		if err != nil {
+			return fmt.Errorf("unable to get replication controller %v: %v", name, err)
+		}
+		rc.Annotations = withAnnotations(rc.Annotations, annotations)
+		_, err = t.client.ReplicationControllers(t.namespace).Update(rc)
+		if err != nil {
+			return fmt.Errorf("unable to annotate replication controller %v: %v", name, err)
+		}
+	case kindDeployment:
+		d, err := t.client.Extensions().Deployments(t.namespace).Get(name)
+		if err != nil {
+			return fmt.Errorf("unable to get deployment %v: %v", name, err)
+		}
+		d.Annotations = withAnnotations(d.Annotations, annotations)
+		_, err = t.client.Extensions().Deployments(t.namespace).Update(d)
+		if err != nil {
+			return fmt.Errorf("unable to annotate deployment %v: %v", name, err)
+		}
+	case kindReplicaSet:
+		ext, ok := t.client.Extensions().(*kclient.ExtensionsClient)
+		if !ok {
+			return fmt.Errorf("annotating %v requires a REST client", t.kind)
+		}
+		// null removes an annotation in a merge patch
+		values := map[string]interface{}{}
+		for key, value := range annotations {
+			if value == "" {
+				values[key] = nil
+			} else {
+				values[key] = value
+			}
+		}
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"annotations": values},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = ext.Patch(api.MergePatchType).Namespace(t.namespace).Resource("replicasets").Name(name).Body(patch).DoRaw()
+		if err != nil {
+			return fmt.Errorf("unable to annotate replica set %v: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func withAnnotations(annotations map[string]string, changes map[string]string) map[string]string {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for key, value := range changes {
+		if value == "" {
+			delete(annotations, key)
+		} else {
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
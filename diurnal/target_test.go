@@ -0,0 +1,378 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// testNow is the time the counts are set at in the tests.
+var testNow = time.Date(2016, 1, 4, 12, 0, 0, 0, time.UTC)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// fakeTargets returns targets of the given kind backed by a fake client that lists the objects
+// and records the updated ones.
+func fakeTargets(kind, mode string, objects ...runtime.Object) (*targets, *[]runtime.Object) {
+	fake := &testclient.Fake{}
+	updated := &[]runtime.Object{}
+	fake.AddReactor("*", "*", func(action testclient.Action) (bool, runtime.Object, error) {
+		switch action.GetVerb() {
+		case "list":
+			for _, obj := range objects {
+				switch list := obj.(type) {
+				case *api.ReplicationControllerList:
+					if action.GetResource() == "replicationcontrollers" {
+						return true, list, nil
+					}
+				case *extensions.DeploymentList:
+					if action.GetResource() == "deployments" {
+						return true, list, nil
+					}
+				case *extensions.HorizontalPodAutoscalerList:
+					if action.GetResource() == "horizontalpodautoscalers" {
+						return true, list, nil
+					}
+				}
+			}
+		case "get":
+			if action.GetSubresource() == "scale" {
+				return true, &extensions.Scale{ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "default"}, Spec: extensions.ScaleSpec{Replicas: 2}}, nil
+			}
+			// the listed object, with copied annotations
+			for _, obj := range objects {
+				switch list := obj.(type) {
+				case *api.ReplicationControllerList:
+					if action.GetResource() == "replicationcontrollers" {
+						rc := list.Items[0]
+						rc.Annotations = withAnnotations(nil, rc.Annotations)
+						return true, &rc, nil
+					}
+				case *extensions.DeploymentList:
+					if action.GetResource() == "deployments" {
+						d := list.Items[0]
+						d.Annotations = withAnnotations(nil, d.Annotations)
+						return true, &d, nil
+					}
+				}
+			}
+			if action.GetResource() == "replicationcontrollers" {
+				return true, &api.ReplicationController{ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "default"}}, nil
+			}
+		case "update":
+			obj := action.(testclient.UpdateAction).GetObject()
+			*updated = append(*updated, obj)
+			return true, obj, nil
+		}
+		return false, nil, nil
+	})
+	return &targets{
+		client:    fake,
+		namespace: "default",
+		kind:      kind,
+		selector:  labels.Everything(),
+		mode:      mode,
+	}, updated
+}
+
+func deployments(annotations map[string]string) *extensions.DeploymentList {
+	return &extensions.DeploymentList{
+		Items: []extensions.Deployment{{ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "default", Annotations: annotations}}},
+	}
+}
+
+func autoscalers(min *int, max int) *extensions.HorizontalPodAutoscalerList {
+	return &extensions.HorizontalPodAutoscalerList{
+		Items: []extensions.HorizontalPodAutoscaler{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "other", Namespace: "default"},
+				Spec: extensions.HorizontalPodAutoscalerSpec{
+					ScaleRef:    extensions.SubresourceReference{Kind: kindDeployment, Name: "other"},
+					MinReplicas: intPtr(1),
+					MaxReplicas: 3,
+				},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "web-hpa", Namespace: "default"},
+				Spec: extensions.HorizontalPodAutoscalerSpec{
+					ScaleRef:    extensions.SubresourceReference{Kind: kindDeployment, Name: "web", Subresource: "scale"},
+					MinReplicas: min,
+					MaxReplicas: max,
+				},
+			},
+		},
+	}
+}
+
+func TestSetCountReplicas(t *testing.T) {
+	rcs := &api.ReplicationControllerList{Items: []api.ReplicationController{{ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "default"}}}}
+	targets, updated := fakeTargets(kindReplicationController, modeReplicas, rcs)
+	if _, err := targets.setCount(4, testNow, testNow, testNow); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*updated) != 1 || (*updated)[0].(*api.ReplicationController).Spec.Replicas != 4 {
+		t.Errorf("expected the replication controller to be scaled to 4, got %v", *updated)
+	}
+
+	targets, updated = fakeTargets(kindDeployment, modeReplicas, deployments(nil))
+	if _, err := targets.setCount(5, testNow, testNow, testNow); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*updated) != 1 || (*updated)[0].(*extensions.Scale).Spec.Replicas != 5 {
+		t.Errorf("expected the scale of the deployment to be set to 5, got %v", *updated)
+	}
+}
+
+func TestSetCountHPA(t *testing.T) {
+	cases := []struct {
+		mode        string
+		annotations map[string]string
+		min         *int
+		max         int
+		count       int
+		expectedMin int
+		expectedMax int
+	}{
+		{modeHPAMin, nil, intPtr(2), 10, 4, 4, 10},
+		{modeHPAMin, nil, intPtr(2), 10, 12, 12, 12},
+		{modeHPAMin, nil, nil, 10, 0, 1, 10},
+		{modeHPAMax, nil, intPtr(2), 10, 6, 2, 6},
+		{modeHPAMax, nil, intPtr(5), 10, 3, 3, 3},
+		{modeHPAMax, nil, nil, 10, 20, 1, 20},
+		// the annotation overrides the mode of the flag
+		{modeReplicas, map[string]string{modeAnnotation: modeHPAMin}, intPtr(2), 10, 4, 4, 10},
+	}
+	for i, test := range cases {
+		targets, updated := fakeTargets(kindDeployment, test.mode, deployments(test.annotations), autoscalers(test.min, test.max))
+		if _, err := targets.setCount(test.count, testNow, testNow, testNow); err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if len(*updated) != 1 {
+			t.Errorf("case %d: expected a single update, got %v", i, *updated)
+			continue
+		}
+		hpa := (*updated)[0].(*extensions.HorizontalPodAutoscaler)
+		if hpa.Name != "web-hpa" || *hpa.Spec.MinReplicas != test.expectedMin || hpa.Spec.MaxReplicas != test.expectedMax {
+			t.Errorf("case %d: expected web-hpa with %d...%d replicas, got %v with %d...%d", i, test.expectedMin, test.expectedMax, hpa.Name, *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+		}
+	}
+}
+
+func TestSetCountErrors(t *testing.T) {
+	cases := []struct {
+		mode        string
+		annotations map[string]string
+	}{
+		// there is no autoscaler for the deployment
+		{modeHPAMin, nil},
+		{modeReplicas, map[string]string{modeAnnotation: "unknown"}},
+	}
+	for i, test := range cases {
+		targets, updated := fakeTargets(kindDeployment, test.mode, deployments(test.annotations), &extensions.HorizontalPodAutoscalerList{})
+		if _, err := targets.setCount(3, testNow, testNow, testNow); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+		if len(*updated) != 0 {
+			t.Errorf("case %d: expected no updates, got %v", i, *updated)
+		}
+	}
+}
+
+func TestValidateKindAndMode(t *testing.T) {
+	cases := []struct {
+		kind string
+		mode string
+		err  bool
+	}{
+		{kindReplicationController, modeReplicas, false},
+		{kindDeployment, modeHPAMin, false},
+		{kindReplicaSet, modeHPAMax, false},
+		{"Pod", modeReplicas, true},
+		{kindDeployment, "hpa", true},
+	}
+	for i, test := range cases {
+		err := validateKindAndMode(test.kind, test.mode)
+		if test.err != (err != nil) {
+			t.Errorf("case %d: expected error %v, got %v", i, test.err, err)
+		}
+	}
+}
+
+func replicationControllers(replicas int, annotations map[string]string) *api.ReplicationControllerList {
+	return &api.ReplicationControllerList{
+		Items: []api.ReplicationController{{
+			ObjectMeta: api.ObjectMeta{Name: "web", Namespace: "default", Annotations: annotations},
+			Spec:       api.ReplicationControllerSpec{Replicas: replicas},
+		}},
+	}
+}
+
+func TestSetCountRamp(t *testing.T) {
+	cases := []struct {
+		ramp     ramp
+		replicas int
+		count    int
+		expected int
+	}{
+		{ramp{}, 10, 40, 40},
+		{ramp{replicas: 5}, 10, 40, 15},
+		{ramp{replicas: 5}, 10, 12, 12},
+		{ramp{percent: 20}, 10, 40, 12},
+		{ramp{percent: 20}, 10, 2, 8},
+		{ramp{replicas: 1, percent: 20}, 10, 40, 11},
+		{ramp{percent: 20}, 0, 4, 1},
+	}
+	for i, test := range cases {
+		targets, updated := fakeTargets(kindReplicationController, modeReplicas, replicationControllers(test.replicas, nil))
+		targets.ramp = test.ramp
+		reached, err := targets.setCount(test.count, testNow, testNow, testNow)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if replicas := (*updated)[0].(*api.ReplicationController).Spec.Replicas; replicas != test.expected {
+			t.Errorf("case %d: expected %d replicas, got %d", i, test.expected, replicas)
+		}
+		if reached != (test.expected == test.count) {
+			t.Errorf("case %d: unexpected reached %v", i, reached)
+		}
+	}
+}
+
+func TestSetCountRespectManual(t *testing.T) {
+	until := testNow.Add(time.Hour)
+	applied := func(count int) string {
+		return (&targets{}).appliedState(modeReplicas, count, testNow)
+	}
+	appliedBefore := func(count int) string {
+		return (&targets{}).appliedState(modeReplicas, count, testNow.Add(-2*time.Hour))
+	}
+	cases := []struct {
+		replicas    int
+		annotations map[string]string
+		// expected is the replicas set, or -1 if the target isn't scaled
+		expected int
+		paused   bool
+	}{
+		// diurnal set the replicas last
+		{5, map[string]string{lastAppliedAnnotation: applied(5)}, 8, false},
+		// nothing was recorded yet
+		{5, nil, 8, false},
+		// the replicas were changed manually
+		{7, map[string]string{lastAppliedAnnotation: applied(5)}, -1, true},
+		// the replicas were changed manually before the point, the override is over
+		{7, map[string]string{lastAppliedAnnotation: appliedBefore(5)}, 8, false},
+		// the target is paused until the next point
+		{7, map[string]string{lastAppliedAnnotation: applied(7), pausedUntilAnnotation: until.Format(time.RFC3339)}, -1, true},
+		// the pause is over
+		{7, map[string]string{lastAppliedAnnotation: applied(7), pausedUntilAnnotation: testNow.Format(time.RFC3339)}, 8, false},
+	}
+	for i, test := range cases {
+		targets, updated := fakeTargets(kindReplicationController, modeReplicas, replicationControllers(test.replicas, test.annotations))
+		targets.respectManual = true
+		reached, err := targets.setCount(8, testNow, testNow, until)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if reached == test.paused {
+			t.Errorf("case %d: unexpected reached %v", i, reached)
+		}
+		var annotations map[string]string
+		if test.expected == -1 {
+			for _, obj := range *updated {
+				if replicas := obj.(*api.ReplicationController).Spec.Replicas; replicas != test.replicas {
+					t.Errorf("case %d: expected the target not to be scaled, got %d replicas", i, replicas)
+				}
+			}
+			if len(*updated) == 0 {
+				continue
+			}
+		} else if replicas := (*updated)[0].(*api.ReplicationController).Spec.Replicas; replicas != test.expected {
+			t.Errorf("case %d: expected %d replicas, got %d", i, test.expected, replicas)
+		}
+		annotations = (*updated)[len(*updated)-1].(*api.ReplicationController).Annotations
+		if _, ok := annotations[pausedUntilAnnotation]; ok != test.paused {
+			t.Errorf("case %d: expected paused %v, got annotations %v", i, test.paused, annotations)
+		}
+		state, ok := lastApplied(targetObject{annotations: annotations})
+		if !ok {
+			t.Errorf("case %d: expected the applied state to be recorded, got %v", i, annotations)
+		} else if expected := test.expected; expected != -1 && state.Count != expected {
+			t.Errorf("case %d: expected the applied count %d, got %d", i, expected, state.Count)
+		} else if expected == -1 && state.Count != test.replicas {
+			t.Errorf("case %d: expected the manual count %d to be recorded, got %d", i, test.replicas, state.Count)
+		}
+	}
+}
+
+// notifyingTargets sends the counts set on the targets once they are set.
+type notifyingTargets struct {
+	*targets
+	set fakeCounts
+}
+
+func (n notifyingTargets) setCount(count int, from, now, until time.Time) (bool, error) {
+	reached, err := n.targets.setCount(count, from, now, until)
+	n.set <- count
+	return reached, err
+}
+
+func TestScalerAppliesPointAfterManualChange(t *testing.T) {
+	s, err := buildSchedule("", "08:00Z,20:00Z", "5,2", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list := replicationControllers(5, nil)
+	targets, updated := fakeTargets(kindReplicationController, modeReplicas, list)
+	targets.respectManual = true
+	counts := notifyingTargets{targets, make(fakeCounts, 10)}
+	clock := &fakeClock{now: time.Date(2016, 3, 12, 9, 0, 0, 0, time.UTC)}
+	sc := &scaler{schedule: s, targets: counts, clock: clock, interval: time.Hour}
+	if err := sc.Start(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sc.Stop()
+	expectCount(t, counts.set, 5, clock.Now())
+
+	// the target is scaled manually until the next point
+	rc := *(*updated)[len(*updated)-1].(*api.ReplicationController)
+	rc.Spec.Replicas = 7
+	list.Items[0] = rc
+	clock.Step(11 * time.Hour)
+	expectCount(t, counts.set, 2, clock.Now())
+	last := (*updated)[len(*updated)-1].(*api.ReplicationController)
+	if _, ok := last.Annotations[pausedUntilAnnotation]; ok {
+		t.Errorf("expected the schedule not to be paused, got annotations %v", last.Annotations)
+	}
+	for _, obj := range *updated {
+		if replicas := obj.(*api.ReplicationController).Spec.Replicas; replicas == 2 {
+			return
+		}
+	}
+	t.Errorf("expected the count of the point to be applied, got %v", *updated)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// buildBinary compiles the packages of args into a static binary at out. Reproducible
+// binaries don't depend on the paths of the packages and have no build ID.
+func buildBinary(args []string, spec *buildSpec, out string, reproducible bool, stderr io.Writer) error {
+	command := []string{"go", "build", "-o", out, "-a", "-tags", "netgo", "-installsuffix", "netgo"}
+	if reproducible {
+		command = append(command, "-trimpath", "-ldflags", "-buildid=")
+	}
+	command = append(command, args...)
+	cmd := exec.Command(command[0], command[1:]...)
+	// the binary is static, for any GOOS and GOARCH
+	cmd.Env = append(os.Environ(),
+		"GOOS="+spec.GOOS,
+		"GOARCH="+spec.GOARCH,
+		"CGO_ENABLED=0",
+	)
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("command %q failed: %v", strings.Join(command, " "), err)
+	}
+	return nil
+}
+
+// sourceDateEpoch returns the time of reproducible images, SOURCE_DATE_EPOCH if set, see
+// https://reproducible-builds.org/specs/source-date-epoch/, or the Unix epoch.
+func sourceDateEpoch() (time.Time, error) {
+	epoch := os.Getenv("SOURCE_DATE_EPOCH")
+	if epoch == "" {
+		return time.Unix(0, 0).UTC(), nil
+	}
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid SOURCE_DATE_EPOCH %q: %v", epoch, err)
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// normalize sets the modification time of the files to created, and their modes to 0755 for
+// directories and executables or 0644 for other files, so layers only depend on the contents
+// and names of the files.
+func normalize(files []layerFile, created time.Time) {
+	for i := range files {
+		f := &files[i]
+		f.modTime = created
+		switch {
+		case f.typeflag == tar.TypeSymlink:
+			f.mode = 0777
+		case f.typeflag == tar.TypeDir || f.mode&0111 != 0:
+			f.mode = 0755
+		default:
+			f.mode = 0644
+		}
+	}
+}
+
+// assembleImage returns the image of the binary, with the CA bundle and the files of the spec.
+// The image of the binary built by args is created at created. Reproducible images have
+// normalized files, and their times are created.
+func assembleImage(spec *buildSpec, args []string, binary, basename string, created time.Time, reproducible bool) (*image, error) {
+	imgConfig, err := spec.config(basename)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(binary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file info %q: %v", binary, err)
+	}
+	binaryData, err := ioutil.ReadFile(binary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %v", binary, err)
+	}
+	files := []layerFile{{name: basename, mode: 0755, modTime: info.ModTime(), data: binaryData}}
+	caCerts, err := readCABundle(spec.CABundle)
+	if err != nil {
+		return nil, err
+	}
+	if caCerts != nil {
+		files = append(files, layerFile{name: caBundlePath, mode: 0644, modTime: created, data: caCerts})
+	}
+	layerFiles := [][]layerFile{files}
+	for _, pair := range spec.Files {
+		files, err := readLayerFiles(pair)
+		if err != nil {
+			return nil, err
+		}
+		layerFiles = append(layerFiles, files)
+	}
+
+	layers := []*layer{}
+	hist := []history{}
+	for _, files := range layerFiles {
+		if reproducible {
+			normalize(files, created)
+		}
+		l, err := newLayer(files)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, l)
+		hist = append(hist, history{Created: created})
+	}
+	if err := setCreatedBy(hist, spec, args, reproducible); err != nil {
+		return nil, err
+	}
+	return newImage(imageConfig{
+		Created:      created,
+		Architecture: spec.GOARCH,
+		OS:           spec.GOOS,
+		Config:       imgConfig,
+		History:      hist,
+	}, layers...)
+}
+
+// setCreatedBy sets the commands that created the layers in their history: the first layer is
+// created by the build of args, the others by the files of the spec. The history of reproducible
+// images doesn't contain host paths, only the import paths of the packages and the DEST paths.
+func setCreatedBy(hist []history, spec *buildSpec, args []string, reproducible bool) error {
+	if !reproducible {
+		hist[0].CreatedBy = "go2docker " + strings.Join(args, " ")
+		for i, pair := range spec.Files {
+			hist[i+1].CreatedBy = "go2docker -add " + pair
+		}
+		return nil
+	}
+	pkgs, err := importPaths(args)
+	if err != nil {
+		return err
+	}
+	hist[0].CreatedBy = "go2docker " + strings.Join(pkgs, " ")
+	for i, pair := range spec.Files {
+		hist[i+1].CreatedBy = "go2docker -add " + strings.SplitN(pair, ":", 2)[1]
+	}
+	return nil
+}
+
+// importPaths returns the import paths of the packages of args. go identifies the packages
+// outside of GOPATH by their directory, "_/<dir>", so only the name of the directory is kept.
+// Go files are the package "command-line-arguments".
+func importPaths(args []string) ([]string, error) {
+	command := append([]string{"go", "list", "-f", "{{.ImportPath}}"}, args...)
+	out, err := exec.Command(command[0], command[1:]...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("command %q failed: %v", strings.Join(command, " "), err)
+	}
+	pkgs := strings.Fields(string(out))
+	for i, pkg := range pkgs {
+		if strings.HasPrefix(pkg, "_/") {
+			pkgs[i] = path.Base(pkg)
+		}
+	}
+	return pkgs, nil
+}
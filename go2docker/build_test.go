@@ -0,0 +1,156 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// buildTestImage builds the image of testdata/hello with the files of static.
+func buildTestImage(t *testing.T, static string, reproducible bool) (*image, error) {
+	dir, err := ioutil.TempDir("", "go2docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	spec := &buildSpec{GOARCH: defaultGOARCH, GOOS: defaultGOOS, CABundle: caBundleNone, Files: []string{static + ":/static"}}
+	args := []string{filepath.Join("testdata", "hello", "hello.go")}
+	binary := filepath.Join(dir, "hello")
+	if err := buildBinary(args, spec, binary, reproducible, ioutil.Discard); err != nil {
+		return nil, err
+	}
+	created := time.Now().UTC()
+	if reproducible {
+		if created, err = sourceDateEpoch(); err != nil {
+			return nil, err
+		}
+	}
+	return assembleImage(spec, args, binary, "hello", created, reproducible)
+}
+
+func TestReproducibleBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping builds in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not found")
+	}
+	os.Setenv("SOURCE_DATE_EPOCH", "1460332800")
+	defer os.Unsetenv("SOURCE_DATE_EPOCH")
+
+	// the files have different paths, times and modes in each build, change
+	// a copy of testdata/static
+	dir, err := ioutil.TempDir("", "go2docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	index, err := ioutil.ReadFile(filepath.Join("testdata", "static", "index.html"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var digests []string
+	for i := 0; i < 2; i++ {
+		static := filepath.Join(dir, fmt.Sprintf("static%d", i))
+		if err := os.Mkdir(static, 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		path := filepath.Join(static, "index.html")
+		if err := ioutil.WriteFile(path, index, 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		touched := time.Now().Add(time.Duration(i) * time.Hour)
+		if err := os.Chtimes(path, touched, touched); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := os.Chmod(path, os.FileMode(0600+i*040)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		img, err := buildTestImage(t, static, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		manifestData, err := img.manifest(ociMediaTypes)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		digests = append(digests, digest(manifestData))
+		var cfg imageConfig
+		if err := json.Unmarshal(img.config, &cfg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !cfg.Created.Equal(time.Unix(1460332800, 0)) {
+			t.Errorf("expected the image to be created at SOURCE_DATE_EPOCH, got %v", cfg.Created)
+		}
+		createdBy := []string{}
+		for _, h := range cfg.History {
+			createdBy = append(createdBy, h.CreatedBy)
+		}
+		if expected := []string{"go2docker command-line-arguments", "go2docker -add /static"}; !reflect.DeepEqual(createdBy, expected) {
+			t.Errorf("expected the history %q, got %q", expected, createdBy)
+		}
+	}
+	if digests[0] != digests[1] {
+		t.Errorf("expected the same image from both builds, got %s and %s", digests[0], digests[1])
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	created := time.Unix(0, 0).UTC()
+	files := []layerFile{
+		{name: "bin", mode: 0700, modTime: time.Now(), typeflag: tar.TypeDir},
+		{name: "bin/app", mode: 0700, modTime: time.Now()},
+		{name: "bin/app.conf", mode: 0600, modTime: time.Now()},
+		{name: "bin/current", mode: 0755, modTime: time.Now(), typeflag: tar.TypeSymlink, linkname: "app"},
+	}
+	normalize(files, created)
+	for i, mode := range []int64{0755, 0755, 0644, 0777} {
+		if files[i].mode != mode || !files[i].modTime.Equal(created) {
+			t.Errorf("expected %s with mode %o at %v, got %o at %v", files[i].name, mode, created, files[i].mode, files[i].modTime)
+		}
+	}
+}
+
+func TestSourceDateEpoch(t *testing.T) {
+	defer os.Unsetenv("SOURCE_DATE_EPOCH")
+	cases := []struct {
+		epoch    string
+		expected time.Time
+		err      bool
+	}{
+		{"", time.Unix(0, 0), false},
+		{"1460332800", time.Date(2016, 4, 11, 0, 0, 0, 0, time.UTC), false},
+		{"yesterday", time.Time{}, true},
+	}
+	for i, test := range cases {
+		os.Setenv("SOURCE_DATE_EPOCH", test.epoch)
+		created, err := sourceDateEpoch()
+		if test.err != (err != nil) || !created.Equal(test.expected) {
+			t.Errorf("case %d: expected %v (error %v), got %v (%v)", i, test.expected, test.err, created, err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// caBundleHost is the -ca-bundle of the trust store of the host.
+	caBundleHost = "host"
+	// caBundleNone is the -ca-bundle of images without CA certificates.
+	caBundleNone = "none"
+	// caBundlePath is where the CA certificates are in the image.
+	caBundlePath = "etc/ssl/certs/ca-certificates.crt"
+)
+
+// hostCABundles are the CA certificates files of the common distributions.
+var hostCABundles = []string{
+	"/etc/ssl/certs/ca-certificates.crt", // Debian, Ubuntu, Alpine
+	"/etc/pki/tls/certs/ca-bundle.crt",   // Fedora, RHEL
+	"/etc/ssl/ca-bundle.pem",             // OpenSUSE
+	"/etc/pki/tls/cacert.pem",            // OpenELEC
+	"/etc/ssl/cert.pem",                  // macOS, FreeBSD
+}
+
+// readCABundle returns the CA certificates of bundle, the trust store of the host with
+// caBundleHost, or nothing with caBundleNone.
+func readCABundle(bundle string) ([]byte, error) {
+	switch bundle {
+	case caBundleNone:
+		return nil, nil
+	case caBundleHost:
+		for _, file := range hostCABundles {
+			if _, err := os.Stat(file); err == nil {
+				return readCABundle(file)
+			}
+		}
+		return nil, fmt.Errorf("no CA certificates found in %s, use -ca-bundle to set them", strings.Join(hostCABundles, ", "))
+	}
+	data, err := ioutil.ReadFile(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificates: %v", err)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no CA certificates in %s", bundle)
+	}
+	return data, nil
+}
+
+// readLayerFiles returns the files of a SRC:DEST pair: the host file or directory SRC at
+// DEST in the image.
+func readLayerFiles(pair string) ([]layerFile, error) {
+	parts := strings.SplitN(pair, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || !path.IsAbs(parts[1]) {
+		return nil, fmt.Errorf("invalid file %q, must be SRC:DEST with an absolute DEST", pair)
+	}
+	src, dest := parts[0], path.Clean(parts[1])
+	var files []layerFile
+	err := filepath.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+		name := path.Join(dest, filepath.ToSlash(rel))
+		if name == "/" {
+			return nil
+		}
+		f := layerFile{
+			name:    name,
+			mode:    int64(info.Mode().Perm()),
+			modTime: info.ModTime(),
+		}
+		switch {
+		case info.IsDir():
+			f.typeflag = tar.TypeDir
+		case info.Mode()&os.ModeSymlink != 0:
+			f.typeflag = tar.TypeSymlink
+			if f.linkname, err = os.Readlink(file); err != nil {
+				return err
+			}
+		case info.Mode().IsRegular():
+			if f.data, err = ioutil.ReadFile(file); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s is not a file, directory or symbolic link", file)
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", src, err)
+	}
+	return files, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadLayerFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go2docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0750); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "sub", "a.txt"), []byte("a"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.Symlink("sub/a.txt", filepath.Join(dir, "link")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	files, err := readLayerFiles(dir + ":/data/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []layerFile{
+		{name: "/data", mode: 0700, typeflag: tar.TypeDir},
+		{name: "/data/link", mode: 0777, typeflag: tar.TypeSymlink, linkname: "sub/a.txt"},
+		{name: "/data/sub", mode: 0750, typeflag: tar.TypeDir},
+		{name: "/data/sub/a.txt", mode: 0600, data: []byte("a")},
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("expected %d files, got %+v", len(expected), files)
+	}
+	for i, e := range expected {
+		f := files[i]
+		if f.name != e.name || f.mode != e.mode || f.typeflag != e.typeflag || f.linkname != e.linkname || string(f.data) != string(e.data) {
+			t.Errorf("expected %+v, got %+v", e, f)
+		}
+	}
+
+	for _, pair := range []string{dir, dir + ":data", filepath.Join(dir, "missing") + ":/data"} {
+		if _, err := readLayerFiles(pair); err == nil {
+			t.Errorf("expected error reading %q", pair)
+		}
+	}
+}
+
+func TestReadCABundle(t *testing.T) {
+	if certs, err := readCABundle(caBundleNone); err != nil || certs != nil {
+		t.Errorf("expected no certificates, got %d bytes (%v)", len(certs), err)
+	}
+	file, err := ioutil.TempFile("", "go2docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("not a certificate")
+	file.Close()
+	if _, err := readCABundle(file.Name()); err == nil {
+		t.Errorf("expected error reading a file without certificates")
+	}
+}
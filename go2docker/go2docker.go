@@ -18,50 +18,44 @@ limitations under the License.
 // docker image from the resulting static binary.
 //
 // usage: go2docker [-image namespace/basename] go/pkg/path | docker load
+//
+// The image can also be pushed to a registry with -push, or written to an
+// OCI image layout with -oci-layout.
 package main
 
 import (
-	"archive/tar"
-	"bytes"
-	"crypto/rand"
-	"encoding/hex"
-	"encoding/json"
 	"flag"
-	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
-	"strings"
 	"time"
 )
 
-type config struct {
-	Cmd []string `json:"Cmd"`
-}
-
-type image struct {
-	ID            string    `json:"id"`
-	Created       time.Time `json:"created"`
-	DockerVersion string    `json:"docker_version"`
-	Config        config    `json:"config"`
-	Architecture  string    `json:"architecture"`
-	OS            string    `json:"os"`
-}
+var (
+	imageName    = flag.String("image", "", "[registry/]namespace/name[:tag] of the image, default to go2docker/$(basename)")
+	push         = flag.Bool("push", false, "push the image to its registry with the v2 API instead of writing it to stdout")
+	ociLayout    = flag.String("oci-layout", "", "write the image to this OCI image layout directory instead of stdout")
+	dockerConfig = flag.String("docker-config", defaultDockerConfig(), "docker config file with the registry credentials")
+	insecure     = flag.Bool("insecure", false, "use plain HTTP to push to the registry")
+	specFile     = flag.String("config", "", "JSON file with the settings of the image, see buildSpec. Flags override it")
+	reproducible = flag.Bool("reproducible", false, "build the same image from the same sources: file times and the creation time are SOURCE_DATE_EPOCH or 0, file modes are normalized and the binary has no build paths or ID")
 
-var imageName = flag.String("image", "", "namespace/name for the repository, default to go2docker/$(basename)")
+	flags specFlags
+)
 
 const (
-	dockerVersion = "1.4.0"
-	arch          = "amd64"
-	goos          = "linux"
-	version       = "1.0"
+	defaultGOARCH = "amd64"
+	defaultGOOS   = "linux"
 	namespace     = "go2docker"
 )
 
+func init() {
+	flags.register(flag.CommandLine)
+}
+
 func main() {
 	flag.Parse()
 	args := []string{"."}
@@ -69,25 +63,20 @@ func main() {
 		args = flag.Args()
 	}
 
-	// check for go
-	goBin, err := exec.LookPath("go")
-	if err != nil {
-		log.Fatalf("`go` executable not found: %v, see %q ", err, "golang.org/doc/install")
-	}
-	toolPath := filepath.Dir(goBin)
-	// check for linux_amd64 toolchain
-	crossPath := filepath.Join(toolPath, "linux_amd64")
-	if _, crossErr := os.Stat(crossPath); os.IsNotExist(crossErr) {
-		// check for make.bash
-		makeBash, err := filepath.Abs(filepath.Join(toolPath, "..", "src", "make.bash"))
-		if err != nil {
-			log.Fatalf("failed to resolve make.bash path: %v", err)
-		}
-		if _, err := os.Stat(makeBash); os.IsNotExist(err) {
-			log.Fatalf("`make.bash` not found %q: %v", makeBash, err)
+	spec := &buildSpec{}
+	if *specFile != "" {
+		var err error
+		if spec, err = loadSpec(*specFile); err != nil {
+			log.Fatal(err)
 		}
-		makeBashCmd := fmt.Sprintf("(cd %s; GOOS=linux GOARCH=amd64 ./make.bash --no-clean)", filepath.Dir(makeBash))
-		log.Fatalf("`go %s` toolchain not found: %v, run: %q", "linux_amd64", crossErr, makeBashCmd)
+	}
+	if err := flags.apply(flag.CommandLine, spec); err != nil {
+		log.Fatal(err)
+	}
+
+	// check for go, which cross compiles since go1.5
+	if _, err := exec.LookPath("go"); err != nil {
+		log.Fatalf("`go` executable not found: %v, see %q ", err, "golang.org/doc/install")
 	}
 
 	fpath, err := filepath.Abs(args[0])
@@ -100,128 +89,50 @@ func main() {
 		}
 		*imageName = path.Join(namespace, basename)
 	}
+	ref, err := parseReference(*imageName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	created := time.Now().UTC()
+	if *reproducible {
+		if created, err = sourceDateEpoch(); err != nil {
+			log.Fatal(err)
+		}
+	}
 	tmpDir, err := ioutil.TempDir("", "")
 	if err != nil {
 		log.Fatalf("failed to create temp directory: %v", err)
 	}
+	defer os.RemoveAll(tmpDir)
 	aout := filepath.Join(tmpDir, basename)
-	command := append([]string{"go", "build", "-o", aout, "-a", "-tags", "netgo", "-installsuffix", "netgo"}, args...)
-	cmd := exec.Command(command[0], command[1:]...)
-	gopath := os.Getenv("GOPATH")
-	cmd.Env = []string{
-		"GOOS=linux",
-		"GOARCH=amd64",
-		"GOPATH=" + gopath,
+	if err := buildBinary(args, spec, aout, *reproducible, os.Stderr); err != nil {
+		log.Fatal(err)
 	}
-	stderr, err := cmd.StderrPipe()
+	img, err := assembleImage(spec, args, aout, basename, created, *reproducible)
 	if err != nil {
-		log.Fatalf("failed to get process stderr: %v", err)
-	}
-	if err := cmd.Start(); err != nil {
-		log.Fatalf("failed to start command %q: %v", strings.Join(command, " "), err)
-	}
-	io.Copy(os.Stderr, stderr)
-	if err := cmd.Wait(); err != nil {
-		log.Fatalf("command %q failed: %v", strings.Join(command, " "), err)
+		log.Fatal(err)
 	}
 
-	imageIDBytes := make([]byte, 32)
-	if _, err := rand.Read(imageIDBytes); err != nil {
-		log.Fatalf("failed to generate ID: %v", err)
-	}
-	imageID := hex.EncodeToString(imageIDBytes)
-	repo := map[string]map[string]string{
-		*imageName: {
-			"latest": imageID,
-		},
-	}
-	repoJSON, err := json.Marshal(repo)
-	if err != nil {
-		log.Fatalf("failed to serialize repo %#v: %v", repo, err)
-	}
-	tw := tar.NewWriter(os.Stdout)
-	if err := tw.WriteHeader(&tar.Header{
-		Name: "repositories",
-		Size: int64(len(repoJSON)),
-	}); err != nil {
-		log.Fatalf("failed to write /repository header: %v", err)
-	}
-	if _, err := tw.Write(repoJSON); err != nil {
-		log.Fatalf(" failed to write /repository body: %v", err)
-	}
-	if err := tw.WriteHeader(&tar.Header{
-		Name: imageID + "/VERSION",
-		Size: int64(len(version)),
-	}); err != nil {
-		log.Fatalf("failed to write /%s/VERSION header: %v", imageID, err)
-	}
-	if _, err := tw.Write([]byte(version)); err != nil {
-		log.Fatalf(" failed to write /%s/VERSION body: %v", imageID, err)
-	}
-	imageJSON, err := json.Marshal(image{
-		ID:            imageID,
-		Created:       time.Now().UTC(),
-		DockerVersion: version,
-		Config: config{
-			Cmd: []string{"/" + basename},
-		},
-		Architecture: arch,
-		OS:           goos,
-	})
-	if err := tw.WriteHeader(&tar.Header{
-		Name: imageID + "/json",
-		Size: int64(len(imageJSON)),
-	}); err != nil {
-		log.Fatalf("failed to write /%s/json header: %v", imageID, err)
-	}
-	if _, err := tw.Write(imageJSON); err != nil {
-		log.Fatalf("failed to write /%s/json body: %v", imageID, err)
-	}
-	var buf bytes.Buffer
-	ftw := tar.NewWriter(&buf)
-	file, err := os.Open(aout)
-	if err != nil {
-		log.Fatalf("failed to open %q: %v", aout, err)
-	}
-	finfo, err := file.Stat()
-	if err != nil {
-		log.Fatalf("failed to get file info %q: %v", aout, err)
-	}
-	fheader, err := tar.FileInfoHeader(finfo, "")
-	if err != nil {
-		log.Fatalf("failed to get file info header %q: %v", aout, err)
-	}
-	fheader.Name = basename
-	if err := ftw.WriteHeader(fheader); err != nil {
-		log.Fatalf("failed to write /%s header: %v", aout, err)
-	}
-	if _, err := io.Copy(ftw, file); err != nil {
-		log.Fatalf("failed to write /%s body: %v", aout, err)
-	}
-	certBytes := []byte(caCerts)
-	if err := ftw.WriteHeader(&tar.Header{
-		Name: "/etc/ssl/certs/ca-certificates.crt",
-		Size: int64(len(certBytes)),
-	}); err != nil {
-		log.Fatalf("failed to write ca-certificates.crt header: %v", err)
-	}
-	if _, err := ftw.Write(certBytes); err != nil {
-		log.Fatalf("failed to write ca-certificates.crt body: %v", err)
-	}
-	if err := ftw.Close(); err != nil {
-		log.Fatalf("failed to close layer.tar: %v", err)
-	}
-	layerBytes := buf.Bytes()
-	if err := tw.WriteHeader(&tar.Header{
-		Name: imageID + "/layer.tar",
-		Size: int64(len(layerBytes)),
-	}); err != nil {
-		log.Fatalf("failed to write /%s/layer.tar header: %v", imageID, err)
+	if *ociLayout != "" {
+		if err := img.writeLayout(*ociLayout, ref.tag); err != nil {
+			log.Fatalf("failed to write OCI image layout: %v", err)
+		}
+		log.Printf("wrote %s to %s", ref, *ociLayout)
 	}
-	if _, err := tw.Write(layerBytes); err != nil {
-		log.Fatalf("failed to write /%s/layer.tar body: %v", imageID, err)
+	if *push {
+		username, password, err := loadCredentials(*dockerConfig, ref.registry)
+		if err != nil {
+			log.Fatal(err)
+		}
+		manifestDigest, err := newRegistryClient(ref, *insecure, username, password).push(img, ref.tag)
+		if err != nil {
+			log.Fatalf("failed to push %s: %v", ref, err)
+		}
+		log.Printf("pushed %s@%s", ref, manifestDigest)
 	}
-	if err := tw.Close(); err != nil {
-		log.Fatalf("failed to close image.tar: %v", err)
+	if *ociLayout == "" && !*push {
+		if err := img.writeArchive(os.Stdout, ref.String()); err != nil {
+			log.Fatalf("failed to write image: %v", err)
+		}
 	}
 }
@@ -0,0 +1,346 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Media types of the Docker v2 schema 2 images, used when pushing to a registry.
+const (
+	dockerManifestType = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerConfigType   = "application/vnd.docker.container.image.v1+json"
+	dockerLayerType    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+)
+
+// Media types of the OCI images, used in image layouts.
+const (
+	ociManifestType  = "application/vnd.oci.image.manifest.v1+json"
+	ociConfigType    = "application/vnd.oci.image.config.v1+json"
+	ociLayerType     = "application/vnd.oci.image.layer.v1.tar+gzip"
+	ociLayoutFile    = "oci-layout"
+	ociLayoutVersion = "1.0.0"
+	// ociRefNameAnnotation is the annotation of the tag of a manifest in the index of a layout.
+	ociRefNameAnnotation = "org.opencontainers.image.ref.name"
+)
+
+// mediaTypes are the media types of the manifest, config and layers of an image format.
+type mediaTypes struct {
+	manifest string
+	config   string
+	layer    string
+}
+
+var (
+	dockerMediaTypes = mediaTypes{dockerManifestType, dockerConfigType, dockerLayerType}
+	ociMediaTypes    = mediaTypes{ociManifestType, ociConfigType, ociLayerType}
+)
+
+// config is the runtime configuration of an image.
+type config struct {
+	Entrypoint   []string            `json:"Entrypoint,omitempty"`
+	Cmd          []string            `json:"Cmd,omitempty"`
+	Env          []string            `json:"Env,omitempty"`
+	ExposedPorts map[string]struct{} `json:"ExposedPorts,omitempty"`
+	Labels       map[string]string   `json:"Labels,omitempty"`
+	User         string              `json:"User,omitempty"`
+	WorkingDir   string              `json:"WorkingDir,omitempty"`
+}
+
+type rootFS struct {
+	Type    string   `json:"type"`
+	DiffIDs []string `json:"diff_ids"`
+}
+
+type history struct {
+	Created   time.Time `json:"created"`
+	CreatedBy string    `json:"created_by,omitempty"`
+}
+
+// imageConfig is the configuration of an image, shared by Docker v2 and OCI images.
+type imageConfig struct {
+	Created      time.Time `json:"created"`
+	Architecture string    `json:"architecture"`
+	OS           string    `json:"os"`
+	Config       config    `json:"config"`
+	RootFS       rootFS    `json:"rootfs"`
+	History      []history `json:"history,omitempty"`
+}
+
+// descriptor references a blob by its digest.
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType,omitempty"`
+	Config        descriptor   `json:"config"`
+	Layers        []descriptor `json:"layers"`
+}
+
+// ociIndex is the index.json of an OCI image layout.
+type ociIndex struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	Manifests     []descriptor `json:"manifests"`
+}
+
+// archiveManifest is an entry of the manifest.json of an archive for docker load.
+type archiveManifest struct {
+	Config   string
+	RepoTags []string
+	Layers   []string
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// layer is a filesystem layer of an image.
+type layer struct {
+	// tar is the uncompressed layer, whose digest is the diff ID of the layer
+	tar []byte
+	// gzip is the compressed layer, the blob stored in registries and layouts
+	gzip []byte
+}
+
+func (l *layer) diffID() string {
+	return digest(l.tar)
+}
+
+// layerFile is a file, directory or symbolic link of a layer.
+type layerFile struct {
+	// name is the path of the file in the image, without leading slash
+	name    string
+	mode    int64
+	modTime time.Time
+	data    []byte
+	// typeflag is tar.TypeReg for files, tar.TypeDir for directories or tar.TypeSymlink
+	// for symbolic links to linkname. The zero value is a file.
+	typeflag byte
+	linkname string
+}
+
+// newLayer returns a layer with the files, and the directories containing them.
+func newLayer(files []layerFile) (*layer, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	dirs := map[string]bool{}
+	for _, f := range files {
+		name := strings.Trim(f.name, "/")
+		// parent directories come first, e.g. etc/ and etc/ssl/ for etc/ssl/certs.pem
+		parts := strings.Split(name, "/")
+		for i := 1; i < len(parts); i++ {
+			dir := strings.Join(parts[:i], "/") + "/"
+			if dirs[dir] {
+				continue
+			}
+			dirs[dir] = true
+			if err := tw.WriteHeader(&tar.Header{
+				Name:     dir,
+				Mode:     0755,
+				ModTime:  f.modTime,
+				Typeflag: tar.TypeDir,
+			}); err != nil {
+				return nil, fmt.Errorf("failed to write %s header: %v", dir, err)
+			}
+		}
+		hdr := &tar.Header{
+			Name:     name,
+			Mode:     f.mode,
+			Size:     int64(len(f.data)),
+			ModTime:  f.modTime,
+			Typeflag: tar.TypeReg,
+		}
+		switch f.typeflag {
+		case tar.TypeDir:
+			if dirs[name+"/"] {
+				continue
+			}
+			dirs[name+"/"] = true
+			hdr.Name, hdr.Size, hdr.Typeflag = name+"/", 0, tar.TypeDir
+		case tar.TypeSymlink:
+			hdr.Size, hdr.Typeflag, hdr.Linkname = 0, tar.TypeSymlink, f.linkname
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, fmt.Errorf("failed to write %s header: %v", name, err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s body: %v", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close layer: %v", err)
+	}
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	if _, err := zw.Write(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("failed to compress layer: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress layer: %v", err)
+	}
+	return &layer{tar: buf.Bytes(), gzip: gz.Bytes()}, nil
+}
+
+// image is a Docker v2 or OCI image.
+type image struct {
+	config []byte
+	layers []*layer
+}
+
+func newImage(cfg imageConfig, layers ...*layer) (*image, error) {
+	cfg.RootFS = rootFS{Type: "layers"}
+	for _, l := range layers {
+		cfg.RootFS.DiffIDs = append(cfg.RootFS.DiffIDs, l.diffID())
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize image config: %v", err)
+	}
+	return &image{config: data, layers: layers}, nil
+}
+
+// manifest returns the manifest of the image with the media types.
+func (img *image) manifest(types mediaTypes) ([]byte, error) {
+	m := manifest{
+		SchemaVersion: 2,
+		MediaType:     types.manifest,
+		Config: descriptor{
+			MediaType: types.config,
+			Digest:    digest(img.config),
+			Size:      int64(len(img.config)),
+		},
+		Layers: []descriptor{},
+	}
+	for _, l := range img.layers {
+		m.Layers = append(m.Layers, descriptor{
+			MediaType: types.layer,
+			Digest:    digest(l.gzip),
+			Size:      int64(len(l.gzip)),
+		})
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize manifest: %v", err)
+	}
+	return data, nil
+}
+
+// writeArchive writes the image as a tarball for docker load, tagged with repoTag.
+func (img *image) writeArchive(out io.Writer, repoTag string) error {
+	w := tar.NewWriter(out)
+	configName := strings.TrimPrefix(digest(img.config), "sha256:") + ".json"
+	entry := archiveManifest{Config: configName, RepoTags: []string{repoTag}}
+	files := []layerFile{{name: configName, data: img.config}}
+	for _, l := range img.layers {
+		name := strings.TrimPrefix(l.diffID(), "sha256:") + "/layer.tar"
+		entry.Layers = append(entry.Layers, name)
+		files = append(files, layerFile{name: name, data: l.tar})
+	}
+	data, err := json.Marshal([]archiveManifest{entry})
+	if err != nil {
+		return fmt.Errorf("failed to serialize manifest.json: %v", err)
+	}
+	files = append(files, layerFile{name: "manifest.json", data: data})
+	for _, f := range files {
+		if err := w.WriteHeader(&tar.Header{
+			Name: f.name,
+			Mode: 0644,
+			Size: int64(len(f.data)),
+		}); err != nil {
+			return fmt.Errorf("failed to write %s header: %v", f.name, err)
+		}
+		if _, err := w.Write(f.data); err != nil {
+			return fmt.Errorf("failed to write %s body: %v", f.name, err)
+		}
+	}
+	return w.Close()
+}
+
+// writeLayout writes the image to an OCI image layout directory, tagged with tag. Images already
+// in the layout are kept, except the one with the same tag.
+func (img *image) writeLayout(dir, tag string) error {
+	manifestData, err := img.manifest(ociMediaTypes)
+	if err != nil {
+		return err
+	}
+	blobs := [][]byte{img.config, manifestData}
+	for _, l := range img.layers {
+		blobs = append(blobs, l.gzip)
+	}
+	blobDir := filepath.Join(dir, "blobs", "sha256")
+	if err := os.MkdirAll(blobDir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %v", blobDir, err)
+	}
+	for _, blob := range blobs {
+		name := filepath.Join(blobDir, strings.TrimPrefix(digest(blob), "sha256:"))
+		if err := ioutil.WriteFile(name, blob, 0644); err != nil {
+			return fmt.Errorf("failed to write blob %s: %v", name, err)
+		}
+	}
+	layout := []byte(`{"imageLayoutVersion":"` + ociLayoutVersion + `"}`)
+	if err := ioutil.WriteFile(filepath.Join(dir, ociLayoutFile), layout, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", ociLayoutFile, err)
+	}
+
+	index := ociIndex{SchemaVersion: 2}
+	indexPath := filepath.Join(dir, "index.json")
+	if data, err := ioutil.ReadFile(indexPath); err == nil {
+		if err := json.Unmarshal(data, &index); err != nil {
+			return fmt.Errorf("failed to parse %s: %v", indexPath, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %v", indexPath, err)
+	}
+	manifests := []descriptor{}
+	for _, m := range index.Manifests {
+		if m.Annotations[ociRefNameAnnotation] != tag {
+			manifests = append(manifests, m)
+		}
+	}
+	index.Manifests = append(manifests, descriptor{
+		MediaType:   ociManifestType,
+		Digest:      digest(manifestData),
+		Size:        int64(len(manifestData)),
+		Annotations: map[string]string{ociRefNameAnnotation: tag},
+	})
+	data, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("failed to serialize index.json: %v", err)
+	}
+	if err := ioutil.WriteFile(indexPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", indexPath, err)
+	}
+	return nil
+}
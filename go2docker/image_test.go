@@ -0,0 +1,154 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readTar returns the contents of the files of a tarball by name.
+func readTar(t *testing.T, data []byte) (map[string][]byte, []*tar.Header) {
+	files := map[string][]byte{}
+	headers := []*tar.Header{}
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		files[hdr.Name] = body
+		headers = append(headers, hdr)
+	}
+	return files, headers
+}
+
+func TestNewLayer(t *testing.T) {
+	img := testImage(t)
+	_, headers := readTar(t, img.layers[0].tar)
+	expected := []struct {
+		name string
+		mode int64
+	}{
+		{"hello", 0755},
+		{"etc/", 0755},
+		{"etc/ssl/", 0755},
+		{"etc/ssl/certs/", 0755},
+		{"etc/ssl/certs/ca-certificates.crt", 0644},
+	}
+	if len(headers) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(headers))
+	}
+	for i, e := range expected {
+		if headers[i].Name != e.name || headers[i].Mode != e.mode {
+			t.Errorf("expected %s with mode %o, got %s with mode %o", e.name, e.mode, headers[i].Name, headers[i].Mode)
+		}
+	}
+
+	var cfg imageConfig
+	if err := json.Unmarshal(img.config, &cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.RootFS.DiffIDs) != 1 || cfg.RootFS.DiffIDs[0] != digest(img.layers[0].tar) {
+		t.Errorf("expected the diff ID of the layer, got %v", cfg.RootFS.DiffIDs)
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	img := testImage(t)
+	var buf bytes.Buffer
+	if err := img.writeArchive(&buf, "go2docker/test:latest"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	files, _ := readTar(t, buf.Bytes())
+	var entries []archiveManifest
+	if err := json.Unmarshal(files["manifest.json"], &entries); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || len(entries[0].Layers) != 1 || entries[0].RepoTags[0] != "go2docker/test:latest" {
+		t.Fatalf("unexpected manifest.json %+v", entries)
+	}
+	if !bytes.Equal(files[entries[0].Config], img.config) {
+		t.Errorf("expected the config in %s", entries[0].Config)
+	}
+	if !bytes.Equal(files[entries[0].Layers[0]], img.layers[0].tar) {
+		t.Errorf("expected the layer in %s", entries[0].Layers[0])
+	}
+}
+
+func TestWriteLayout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go2docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	img := testImage(t)
+	// the image is written twice with the same tag and once with another one
+	for _, tag := range []string{"1.0", "1.0", "latest"} {
+		if err := img.writeLayout(dir, tag); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, ociLayoutFile)); err != nil {
+		t.Errorf("expected %s: %v", ociLayoutFile, err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var index ociIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(index.Manifests) != 2 {
+		t.Fatalf("expected a manifest per tag, got %+v", index.Manifests)
+	}
+
+	readBlob := func(d descriptor) []byte {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "blobs", "sha256", strings.TrimPrefix(d.Digest, "sha256:")))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if digest(data) != d.Digest || int64(len(data)) != d.Size {
+			t.Errorf("blob %s doesn't match its descriptor", d.Digest)
+		}
+		return data
+	}
+	var m manifest
+	if err := json.Unmarshal(readBlob(index.Manifests[0]), &m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.MediaType != ociManifestType || m.Config.MediaType != ociConfigType || len(m.Layers) != 1 || m.Layers[0].MediaType != ociLayerType {
+		t.Errorf("unexpected manifest %+v", m)
+	}
+	readBlob(m.Config)
+	readBlob(m.Layers[0])
+}
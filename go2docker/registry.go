@@ -0,0 +1,379 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	defaultRegistry = "docker.io"
+	defaultTag      = "latest"
+	// dockerHubHost serves the registry API of docker.io.
+	dockerHubHost = "registry-1.docker.io"
+	// dockerHubAuthKey is the key of the docker.io credentials in docker config files.
+	dockerHubAuthKey = "https://index.docker.io/v1/"
+)
+
+// reference is a parsed image name, e.g. registry.example.com:5000/team/app:1.0.
+type reference struct {
+	registry   string
+	repository string
+	tag        string
+}
+
+// parseReference parses an image name. Names without registry are on docker.io, and names
+// without tag are tagged latest.
+func parseReference(name string) (reference, error) {
+	ref := reference{registry: defaultRegistry, tag: defaultTag}
+	remainder := name
+	if i := strings.Index(name, "/"); i != -1 {
+		host := name[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			ref.registry = host
+			remainder = name[i+1:]
+		}
+	}
+	if i := strings.LastIndex(remainder, ":"); i != -1 && !strings.Contains(remainder[i:], "/") {
+		ref.tag = remainder[i+1:]
+		remainder = remainder[:i]
+	}
+	if remainder == "" || ref.tag == "" {
+		return reference{}, fmt.Errorf("invalid image name %q", name)
+	}
+	if remainder != strings.ToLower(remainder) {
+		return reference{}, fmt.Errorf("invalid image name %q: repository must be lowercase", name)
+	}
+	if ref.registry == defaultRegistry && !strings.Contains(remainder, "/") {
+		remainder = path.Join("library", remainder)
+	}
+	ref.repository = remainder
+	return ref, nil
+}
+
+// String returns the name of the image, without the docker.io registry.
+func (r reference) String() string {
+	if r.registry == defaultRegistry {
+		return strings.TrimPrefix(r.repository, "library/") + ":" + r.tag
+	}
+	return r.registry + "/" + r.repository + ":" + r.tag
+}
+
+// apiHost returns the host serving the registry API of the registry.
+func (r reference) apiHost() string {
+	if r.registry == defaultRegistry {
+		return dockerHubHost
+	}
+	return r.registry
+}
+
+// dockerConfigFile is the part of a docker config file, e.g. ~/.docker/config.json, with the
+// credentials of registries.
+type dockerConfigFile struct {
+	Auths map[string]struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"auths"`
+}
+
+// defaultDockerConfig returns the path of the docker config file of the user.
+func defaultDockerConfig() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return path.Join(dir, "config.json")
+	}
+	return path.Join(os.Getenv("HOME"), ".docker", "config.json")
+}
+
+// registryHost returns the host of a key of the auths of a docker config file, which can be
+// a host or a URL.
+func registryHost(key string) string {
+	if u, err := url.Parse(key); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return strings.SplitN(key, "/", 2)[0]
+}
+
+// loadCredentials returns the username and password of the registry in the docker config
+// file, if any.
+func loadCredentials(configPath, registry string) (string, string, error) {
+	data, err := ioutil.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read %s: %v", configPath, err)
+	}
+	var cfg dockerConfigFile
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return "", "", fmt.Errorf("failed to parse %s: %v", configPath, err)
+	}
+	hosts := []string{registry}
+	if registry == defaultRegistry {
+		hosts = []string{registryHost(dockerHubAuthKey), defaultRegistry, dockerHubHost}
+	}
+	for key, auth := range cfg.Auths {
+		if !contains(hosts, registryHost(key)) {
+			continue
+		}
+		if auth.Auth == "" {
+			return auth.Username, auth.Password, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid auth of %s in %s: %v", key, configPath, err)
+		}
+		parts := strings.SplitN(string(decoded), ":", 2)
+		if len(parts) != 2 {
+			return "", "", fmt.Errorf("invalid auth of %s in %s", key, configPath)
+		}
+		return parts[0], parts[1], nil
+	}
+	return "", "", nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// parseChallenge parses a WWW-Authenticate header, e.g.
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io".
+func parseChallenge(header string) (string, map[string]string) {
+	params := map[string]string{}
+	parts := strings.SplitN(strings.TrimSpace(header), " ", 2)
+	scheme := strings.ToLower(parts[0])
+	if len(parts) == 1 {
+		return scheme, params
+	}
+	s := parts[1]
+	for s != "" {
+		eq := strings.Index(s, "=")
+		if eq == -1 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:eq]))
+		s = s[eq+1:]
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			end := strings.Index(s[1:], `"`)
+			if end == -1 {
+				value, s = s[1:], ""
+			} else {
+				value, s = s[1:end+1], s[end+2:]
+			}
+		} else if comma := strings.Index(s, ","); comma != -1 {
+			value, s = s[:comma], s[comma:]
+		} else {
+			value, s = s, ""
+		}
+		params[key] = value
+		s = strings.TrimLeft(s, ", ")
+	}
+	return scheme, params
+}
+
+// registryClient pushes images to a repository with the registry v2 API.
+type registryClient struct {
+	client     *http.Client
+	base       string
+	repository string
+	username   string
+	password   string
+	// authorization is the Authorization header, set after the registry asks for it
+	authorization string
+}
+
+func newRegistryClient(ref reference, insecure bool, username, password string) *registryClient {
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	return &registryClient{
+		client:     http.DefaultClient,
+		base:       scheme + "://" + ref.apiHost(),
+		repository: ref.repository,
+		username:   username,
+		password:   password,
+	}
+}
+
+// do sends a request, authenticating and sending it again if the registry asks for it.
+func (c *registryClient) do(method, u, contentType string, body []byte) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(method, u, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if c.authorization != "" {
+			req.Header.Set("Authorization", c.authorization)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized || attempt > 0 {
+			return resp, nil
+		}
+		resp.Body.Close()
+		if err := c.authenticate(resp.Header.Get("WWW-Authenticate")); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// authenticate sets the authorization asked for by a challenge of the registry.
+func (c *registryClient) authenticate(challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if c.username == "" {
+			return fmt.Errorf("registry requires credentials, none found")
+		}
+		c.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(c.username+":"+c.password))
+		return nil
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return fmt.Errorf("invalid realm in challenge %q", challenge)
+		}
+		query := realm.Query()
+		if service, ok := params["service"]; ok {
+			query.Set("service", service)
+		}
+		query.Set("scope", fmt.Sprintf("repository:%s:pull,push", c.repository))
+		realm.RawQuery = query.Encode()
+		req, err := http.NewRequest("GET", realm.String(), nil)
+		if err != nil {
+			return err
+		}
+		if c.username != "" {
+			req.SetBasicAuth(c.username, c.password)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to get token: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return responseError("failed to get token", resp)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return fmt.Errorf("failed to parse token: %v", err)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		c.authorization = "Bearer " + token.Token
+		return nil
+	}
+	return fmt.Errorf("unsupported authentication challenge %q", challenge)
+}
+
+func responseError(msg string, resp *http.Response) error {
+	body, _ := ioutil.ReadAll(resp.Body)
+	return fmt.Errorf("%s: %s: %s", msg, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// push pushes the blobs and the manifest of the image, tagged with tag, and returns the
+// digest of the manifest.
+func (c *registryClient) push(img *image, tag string) (string, error) {
+	manifestData, err := img.manifest(dockerMediaTypes)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range img.layers {
+		if err := c.pushBlob(l.gzip); err != nil {
+			return "", err
+		}
+	}
+	if err := c.pushBlob(img.config); err != nil {
+		return "", err
+	}
+	resp, err := c.do("PUT", fmt.Sprintf("%s/v2/%s/manifests/%s", c.base, c.repository, tag), dockerManifestType, manifestData)
+	if err != nil {
+		return "", fmt.Errorf("failed to push manifest: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return "", responseError("failed to push manifest", resp)
+	}
+	return digest(manifestData), nil
+}
+
+// pushBlob uploads a blob, unless the repository has it already.
+func (c *registryClient) pushBlob(data []byte) error {
+	d := digest(data)
+	resp, err := c.do("HEAD", fmt.Sprintf("%s/v2/%s/blobs/%s", c.base, c.repository, d), "", nil)
+	if err != nil {
+		return fmt.Errorf("failed to check blob %s: %v", d, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	resp, err = c.do("POST", fmt.Sprintf("%s/v2/%s/blobs/uploads/", c.base, c.repository), "", nil)
+	if err != nil {
+		return fmt.Errorf("failed to start upload of blob %s: %v", d, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return responseError(fmt.Sprintf("failed to start upload of blob %s", d), resp)
+	}
+	base, err := url.Parse(c.base)
+	if err != nil {
+		return err
+	}
+	location, err := base.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return fmt.Errorf("invalid upload location %q: %v", resp.Header.Get("Location"), err)
+	}
+	query := location.Query()
+	query.Set("digest", d)
+	location.RawQuery = query.Encode()
+
+	resp, err = c.do("PUT", location.String(), "application/octet-stream", data)
+	if err != nil {
+		return fmt.Errorf("failed to upload blob %s: %v", d, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return responseError(fmt.Sprintf("failed to upload blob %s", d), resp)
+	}
+	return nil
+}
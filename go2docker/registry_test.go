@@ -0,0 +1,272 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testRegistry is an in-process registry implementing the part of the v2 API used to push
+// images. It requires a bearer token when username is set.
+type testRegistry struct {
+	lock      sync.Mutex
+	server    *httptest.Server
+	username  string
+	password  string
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	uploads   int
+	// denied is the error body of the uploads if set
+	denied string
+}
+
+func newTestRegistry(username, password string) *testRegistry {
+	r := &testRegistry{
+		username:  username,
+		password:  password,
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+	}
+	r.server = httptest.NewServer(r)
+	return r
+}
+
+func (r *testRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "http://")
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if req.URL.Path == "/token" {
+		if user, pass, ok := req.BasicAuth(); !ok || user != r.username || pass != r.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"token": "secret"}`)
+		return
+	}
+	if r.username != "" && req.Header.Get("Authorization") != "Bearer secret" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/v2/"), "/"), "/")
+	if len(parts) < 3 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	// the repository is go2docker/test in the tests
+	kind, ref := parts[len(parts)-2], parts[len(parts)-1]
+	body, _ := ioutil.ReadAll(req.Body)
+	switch {
+	case req.Method == "HEAD" && kind == "blobs":
+		if _, ok := r.blobs[ref]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case req.Method == "POST" && kind == "blobs" && ref == "uploads":
+		if r.denied != "" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, r.denied)
+			return
+		}
+		r.uploads++
+		w.Header().Set("Location", fmt.Sprintf("/v2/go2docker/test/blobs/uploads/%d?state=x", r.uploads))
+		w.WriteHeader(http.StatusAccepted)
+	case req.Method == "PUT" && kind == "uploads":
+		d := req.URL.Query().Get("digest")
+		if d != digest(body) || req.URL.Query().Get("state") != "x" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "digest %s doesn't match", d)
+			return
+		}
+		r.blobs[d] = body
+		w.WriteHeader(http.StatusCreated)
+	case req.Method == "PUT" && kind == "manifests":
+		var m manifest
+		if err := json.Unmarshal(body, &m); err != nil || req.Header.Get("Content-Type") != dockerManifestType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, d := range append(m.Layers, m.Config) {
+			if _, ok := r.blobs[d.Digest]; !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "blob %s unknown", d.Digest)
+				return
+			}
+		}
+		r.manifests[ref] = body
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testImage(t *testing.T) *image {
+	created := time.Date(2016, 4, 1, 0, 0, 0, 0, time.UTC)
+	l, err := newLayer([]layerFile{
+		{name: "hello", mode: 0755, modTime: created, data: []byte("binary")},
+		{name: "etc/ssl/certs/ca-certificates.crt", mode: 0644, modTime: created, data: []byte("certs")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	img, err := newImage(imageConfig{Created: created, Architecture: defaultGOARCH, OS: defaultGOOS, Config: config{Cmd: []string{"/hello"}}}, l)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return img
+}
+
+func TestPush(t *testing.T) {
+	for _, username := range []string{"", "user"} {
+		registry := newTestRegistry(username, "pass")
+		defer registry.server.Close()
+		ref, err := parseReference(registry.host() + "/go2docker/test:1.0")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		img := testImage(t)
+		client := newRegistryClient(ref, true, username, "pass")
+		manifestDigest, err := client.push(img, ref.tag)
+		if err != nil {
+			t.Fatalf("unexpected error pushing with user %q: %v", username, err)
+		}
+		pushed, ok := registry.manifests["1.0"]
+		if !ok || digest(pushed) != manifestDigest {
+			t.Errorf("expected manifest %s to be pushed, got %v", manifestDigest, registry.manifests)
+		}
+		if len(registry.blobs) != 2 || registry.uploads != 2 {
+			t.Errorf("expected the config and layer to be uploaded, got %d blobs in %d uploads", len(registry.blobs), registry.uploads)
+		}
+		// blobs in the registry aren't uploaded again
+		if _, err := client.push(img, "latest"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if registry.uploads != 2 {
+			t.Errorf("expected no more uploads, got %d", registry.uploads)
+		}
+	}
+}
+
+func TestPushWithoutCredentials(t *testing.T) {
+	registry := newTestRegistry("user", "pass")
+	defer registry.server.Close()
+	ref, _ := parseReference(registry.host() + "/go2docker/test")
+	if _, err := newRegistryClient(ref, true, "user", "wrong").push(testImage(t), ref.tag); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestPushError(t *testing.T) {
+	registry := newTestRegistry("", "")
+	defer registry.server.Close()
+	registry.denied = `{"errors":[{"code":"DENIED","message":"quota exceeded"}]}`
+	ref, _ := parseReference(registry.host() + "/go2docker/test")
+	_, err := newRegistryClient(ref, true, "", "").push(testImage(t), ref.tag)
+	if err == nil || !strings.Contains(err.Error(), "quota exceeded") {
+		t.Errorf("expected the error of the registry, got %v", err)
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected reference
+		str      string
+	}{
+		{"hello", reference{defaultRegistry, "library/hello", "latest"}, "hello:latest"},
+		{"go2docker/hello:1.0", reference{defaultRegistry, "go2docker/hello", "1.0"}, "go2docker/hello:1.0"},
+		{"localhost:5000/team/app", reference{"localhost:5000", "team/app", "latest"}, "localhost:5000/team/app:latest"},
+		{"gcr.io/project/app:v2", reference{"gcr.io", "project/app", "v2"}, "gcr.io/project/app:v2"},
+	}
+	for i, test := range cases {
+		ref, err := parseReference(test.name)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if ref != test.expected || ref.String() != test.str {
+			t.Errorf("case %d: expected %+v (%s), got %+v (%s)", i, test.expected, test.str, ref, ref)
+		}
+	}
+	for _, name := range []string{"", "app:", "Team/App"} {
+		if _, err := parseReference(name); err == nil {
+			t.Errorf("expected error parsing %q", name)
+		}
+	}
+}
+
+func TestLoadCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go2docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	configPath := filepath.Join(dir, "config.json")
+	data := fmt.Sprintf(`{"auths": {"%s": {"auth": "%s"}, "https://gcr.io": {"username": "_token", "password": "abc"}}}`,
+		dockerHubAuthKey, base64.StdEncoding.EncodeToString([]byte("hub:secret")))
+	if err := ioutil.WriteFile(configPath, []byte(data), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cases := []struct {
+		registry string
+		username string
+		password string
+	}{
+		{defaultRegistry, "hub", "secret"},
+		{"gcr.io", "_token", "abc"},
+		{"localhost:5000", "", ""},
+	}
+	for i, test := range cases {
+		username, password, err := loadCredentials(configPath, test.registry)
+		if err != nil || username != test.username || password != test.password {
+			t.Errorf("case %d: expected %q/%q, got %q/%q (%v)", i, test.username, test.password, username, password, err)
+		}
+	}
+	if username, _, err := loadCredentials(filepath.Join(dir, "missing.json"), defaultRegistry); err != nil || username != "" {
+		t.Errorf("expected no credentials without config file, got %q (%v)", username, err)
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:a/b:pull,push"`)
+	expected := map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:a/b:pull,push",
+	}
+	if scheme != "bearer" || len(params) != len(expected) {
+		t.Fatalf("expected bearer %v, got %v %v", expected, scheme, params)
+	}
+	for k, v := range expected {
+		if params[k] != v {
+			t.Errorf("expected %s=%q, got %q", k, v, params[k])
+		}
+	}
+}
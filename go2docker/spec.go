@@ -0,0 +1,195 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// stringList is a flag that can be repeated.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// buildSpec are the settings of an image, read from the -config file and the flags.
+type buildSpec struct {
+	Entrypoint   []string          `json:"entrypoint"`
+	Cmd          []string          `json:"cmd"`
+	Env          []string          `json:"env"`
+	ExposedPorts []string          `json:"exposedPorts"`
+	Labels       map[string]string `json:"labels"`
+	User         string            `json:"user"`
+	WorkingDir   string            `json:"workingDir"`
+	// Files are SRC:DEST pairs of host files or directories added to the image, each one
+	// in its own layer.
+	Files    []string `json:"files"`
+	GOARCH   string   `json:"goarch"`
+	GOOS     string   `json:"goos"`
+	CABundle string   `json:"caBundle"`
+}
+
+// loadSpec reads a build spec from a JSON file.
+func loadSpec(file string) (*buildSpec, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", file, err)
+	}
+	spec := &buildSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+	}
+	return spec, nil
+}
+
+// specFlags are the flags that override or extend the build spec.
+type specFlags struct {
+	entrypoint stringList
+	cmd        stringList
+	env        stringList
+	expose     stringList
+	labels     stringList
+	files      stringList
+	user       string
+	workingDir string
+	goarch     string
+	goos       string
+	caBundle   string
+}
+
+func (f *specFlags) register(fs *flag.FlagSet) {
+	fs.Var(&f.entrypoint, "entrypoint", "entrypoint of the image, repeated for each argument")
+	fs.Var(&f.cmd, "cmd", "command of the image, repeated for each argument, default to the binary without -entrypoint")
+	fs.Var(&f.env, "env", "KEY=VALUE environment variable of the image (can be repeated)")
+	fs.Var(&f.expose, "expose", "PORT[/tcp|udp] exposed by the image (can be repeated)")
+	fs.Var(&f.labels, "label", "KEY=VALUE label of the image (can be repeated)")
+	fs.Var(&f.files, "add", "SRC:DEST file or directory of the host added to the image in its own layer (can be repeated)")
+	fs.StringVar(&f.user, "user", "", "user the command of the image runs as")
+	fs.StringVar(&f.workingDir, "workdir", "", "working directory of the command of the image")
+	fs.StringVar(&f.goarch, "goarch", defaultGOARCH, "GOARCH of the binary and architecture of the image")
+	fs.StringVar(&f.goos, "goos", defaultGOOS, "GOOS of the binary and OS of the image")
+	fs.StringVar(&f.caBundle, "ca-bundle", caBundleHost, "CA certificates file added to the image, "+caBundleHost+" for the trust store of the host or "+caBundleNone+" for none")
+}
+
+// apply overrides the spec with the flags that were set in fs. Lists of arguments are
+// replaced, other lists are extended.
+func (f *specFlags) apply(fs *flag.FlagSet, spec *buildSpec) error {
+	var err error
+	fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "entrypoint":
+			spec.Entrypoint = f.entrypoint
+		case "cmd":
+			spec.Cmd = f.cmd
+		case "user":
+			spec.User = f.user
+		case "workdir":
+			spec.WorkingDir = f.workingDir
+		case "goarch":
+			spec.GOARCH = f.goarch
+		case "goos":
+			spec.GOOS = f.goos
+		case "ca-bundle":
+			spec.CABundle = f.caBundle
+		}
+	})
+	spec.Env = append(spec.Env, f.env...)
+	spec.ExposedPorts = append(spec.ExposedPorts, f.expose...)
+	spec.Files = append(spec.Files, f.files...)
+	for _, label := range f.labels {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			err = fmt.Errorf("invalid label %q, must be KEY=VALUE", label)
+			continue
+		}
+		if spec.Labels == nil {
+			spec.Labels = map[string]string{}
+		}
+		spec.Labels[parts[0]] = parts[1]
+	}
+	if spec.GOARCH == "" {
+		spec.GOARCH = defaultGOARCH
+	}
+	if spec.GOOS == "" {
+		spec.GOOS = defaultGOOS
+	}
+	if spec.CABundle == "" {
+		spec.CABundle = caBundleHost
+	}
+	return err
+}
+
+// config returns the runtime configuration of the image of the spec, whose binary is
+// /basename.
+func (spec *buildSpec) config(basename string) (config, error) {
+	cfg := config{
+		Entrypoint: spec.Entrypoint,
+		Cmd:        spec.Cmd,
+		Labels:     spec.Labels,
+		User:       spec.User,
+		WorkingDir: spec.WorkingDir,
+	}
+	if len(cfg.Entrypoint) == 0 && len(cfg.Cmd) == 0 {
+		cfg.Cmd = []string{"/" + basename}
+	}
+	for _, env := range spec.Env {
+		if i := strings.Index(env, "="); i < 1 {
+			return config{}, fmt.Errorf("invalid environment variable %q, must be KEY=VALUE", env)
+		}
+		cfg.Env = append(cfg.Env, env)
+	}
+	for _, p := range spec.ExposedPorts {
+		port, err := parsePort(p)
+		if err != nil {
+			return config{}, err
+		}
+		if cfg.ExposedPorts == nil {
+			cfg.ExposedPorts = map[string]struct{}{}
+		}
+		cfg.ExposedPorts[port] = struct{}{}
+	}
+	if cfg.WorkingDir != "" && !path.IsAbs(cfg.WorkingDir) {
+		return config{}, fmt.Errorf("working directory %q must be absolute", cfg.WorkingDir)
+	}
+	return cfg, nil
+}
+
+// parsePort returns the port with its protocol, e.g. 8080/tcp for 8080.
+func parsePort(p string) (string, error) {
+	parts := strings.SplitN(p, "/", 2)
+	proto := "tcp"
+	if len(parts) == 2 {
+		proto = strings.ToLower(parts[1])
+	}
+	port, err := strconv.Atoi(parts[0])
+	if err != nil || port < 1 || port > 65535 || (proto != "tcp" && proto != "udp") {
+		return "", fmt.Errorf("invalid port %q, must be PORT[/tcp|udp]", p)
+	}
+	return fmt.Sprintf("%d/%s", port, proto), nil
+}
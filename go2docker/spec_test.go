@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestSpecFlags(t *testing.T) {
+	spec := &buildSpec{
+		Cmd:    []string{"/hello", "-v"},
+		Env:    []string{"A=1"},
+		Labels: map[string]string{"team": "a"},
+		GOARCH: "arm",
+	}
+	var f specFlags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	f.register(fs)
+	err := fs.Parse([]string{"-cmd", "/hello", "-cmd", "serve", "-env", "B=2", "-label", "team=b", "-expose", "8080", "-expose", "53/udp", "-workdir", "/data"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := f.apply(fs, spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.GOARCH != "arm" || spec.GOOS != defaultGOOS || spec.CABundle != caBundleHost {
+		t.Errorf("unexpected GOARCH %q, GOOS %q or CA bundle %q", spec.GOARCH, spec.GOOS, spec.CABundle)
+	}
+	cfg, err := spec.config("hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := config{
+		Cmd:          []string{"/hello", "serve"},
+		Env:          []string{"A=1", "B=2"},
+		ExposedPorts: map[string]struct{}{"8080/tcp": {}, "53/udp": {}},
+		Labels:       map[string]string{"team": "b"},
+		WorkingDir:   "/data",
+	}
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("expected %+v, got %+v", expected, cfg)
+	}
+}
+
+func TestSpecConfig(t *testing.T) {
+	cfg, err := (&buildSpec{}).config("hello")
+	if err != nil || !reflect.DeepEqual(cfg.Cmd, []string{"/hello"}) {
+		t.Errorf("expected the binary as command, got %v (%v)", cfg.Cmd, err)
+	}
+	cfg, err = (&buildSpec{Entrypoint: []string{"/hello"}}).config("hello")
+	if err != nil || cfg.Cmd != nil {
+		t.Errorf("expected no command with an entrypoint, got %v (%v)", cfg.Cmd, err)
+	}
+
+	invalid := []buildSpec{
+		{Env: []string{"=1"}},
+		{Env: []string{"A"}},
+		{ExposedPorts: []string{"http"}},
+		{ExposedPorts: []string{"80/sctp"}},
+		{ExposedPorts: []string{"70000"}},
+		{WorkingDir: "data"},
+	}
+	for i, spec := range invalid {
+		if _, err := spec.config("hello"); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
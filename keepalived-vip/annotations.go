@@ -0,0 +1,173 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+const (
+	// ipvsScheduler is the LVS scheduler used to balance the connections
+	// of the service: rr, wrr, lc, wlc, lblc, lblcr, dh, sh, sed or nq.
+	ipvsScheduler = "k8s.io/lvs-scheduler"
+	// ipvsMethod is the LVS forwarding method: NAT, DR or TUN.
+	ipvsMethod = "k8s.io/lvs-method"
+	// ipvsPersistenceTimeout is the number of seconds the connections of a
+	// client are sent to the same backend. 0 disables persistence.
+	ipvsPersistenceTimeout = "k8s.io/lvs-persistence-timeout"
+	// ipvsHealthCheck is the check of the backends: TCP, HTTP or NONE.
+	// It defaults to TCP for TCP ports and NONE for UDP ports.
+	ipvsHealthCheck = "k8s.io/lvs-health-check"
+	// ipvsHealthCheckPath is the path requested by the HTTP check.
+	ipvsHealthCheckPath = "k8s.io/lvs-health-check-path"
+
+	defaultScheduler          = "wlc"
+	defaultMethod             = "NAT"
+	defaultPersistenceTimeout = 1800
+	defaultHealthCheckPath    = "/"
+
+	healthCheckTCP  = "TCP"
+	healthCheckHTTP = "HTTP"
+	healthCheckNone = "NONE"
+)
+
+var (
+	lvsSchedulers   = []string{"rr", "wrr", "lc", "wlc", "lblc", "lblcr", "dh", "sh", "sed", "nq"}
+	lvsMethods      = []string{"NAT", "DR", "TUN"}
+	lvsHealthChecks = []string{healthCheckTCP, healthCheckHTTP, healthCheckNone}
+)
+
+// lvsConfig is the LVS configuration of the ports of a service.
+type lvsConfig struct {
+	Scheduler          string
+	Method             string
+	PersistenceTimeout int
+	HealthCheck        string
+	HealthCheckPath    string
+}
+
+type serviceAnnotations map[string]string
+
+func (s serviceAnnotations) getScheduler() (string, bool) {
+	val, ok := s[ipvsScheduler]
+	return val, ok
+}
+
+func (s serviceAnnotations) getMethod() (string, bool) {
+	val, ok := s[ipvsMethod]
+	return val, ok
+}
+
+func (s serviceAnnotations) getPersistenceTimeout() (string, bool) {
+	val, ok := s[ipvsPersistenceTimeout]
+	return val, ok
+}
+
+func (s serviceAnnotations) getHealthCheck() (string, bool) {
+	val, ok := s[ipvsHealthCheck]
+	return val, ok
+}
+
+func (s serviceAnnotations) getHealthCheckPath() (string, bool) {
+	val, ok := s[ipvsHealthCheckPath]
+	return val, ok
+}
+
+// lvsConfig returns the LVS configuration of the service, using the
+// defaults for the missing annotations. It returns an error if one of the
+// annotations has an invalid value.
+func (s serviceAnnotations) lvsConfig() (*lvsConfig, error) {
+	cfg := &lvsConfig{
+		Scheduler:          defaultScheduler,
+		Method:             defaultMethod,
+		PersistenceTimeout: defaultPersistenceTimeout,
+		HealthCheckPath:    defaultHealthCheckPath,
+	}
+
+	if val, ok := s.getScheduler(); ok {
+		if stringSlice(lvsSchedulers).pos(val) == -1 {
+			return nil, fmt.Errorf("invalid %v %q, must be one of %v", ipvsScheduler, val, lvsSchedulers)
+		}
+		cfg.Scheduler = val
+	}
+	if val, ok := s.getMethod(); ok {
+		val = strings.ToUpper(val)
+		if stringSlice(lvsMethods).pos(val) == -1 {
+			return nil, fmt.Errorf("invalid %v %q, must be one of %v", ipvsMethod, val, lvsMethods)
+		}
+		cfg.Method = val
+	}
+	if val, ok := s.getPersistenceTimeout(); ok {
+		timeout, err := strconv.Atoi(val)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid %v %q, must be a number of seconds", ipvsPersistenceTimeout, val)
+		}
+		cfg.PersistenceTimeout = timeout
+	}
+	if val, ok := s.getHealthCheck(); ok {
+		val = strings.ToUpper(val)
+		if stringSlice(lvsHealthChecks).pos(val) == -1 {
+			return nil, fmt.Errorf("invalid %v %q, must be one of %v", ipvsHealthCheck, val, lvsHealthChecks)
+		}
+		cfg.HealthCheck = val
+	}
+	if val, ok := s.getHealthCheckPath(); ok {
+		if !strings.HasPrefix(val, "/") || strings.ContainsAny(val, " \t\n\"{}") {
+			return nil, fmt.Errorf("invalid %v %q, must be an absolute path", ipvsHealthCheckPath, val)
+		}
+		cfg.HealthCheckPath = val
+	}
+
+	return cfg, nil
+}
+
+// forPort returns the configuration of a port of the service with the given
+// protocol and backends, or an error if the configuration can't be used for it.
+func (cfg lvsConfig) forPort(protocol api.Protocol, port int, backends []service) (lvsConfig, error) {
+	switch protocol {
+	case api.ProtocolTCP:
+		if cfg.HealthCheck == "" {
+			cfg.HealthCheck = healthCheckTCP
+		}
+	case api.ProtocolUDP:
+		if cfg.HealthCheck == "" {
+			cfg.HealthCheck = healthCheckNone
+		}
+		if cfg.HealthCheck != healthCheckNone {
+			return cfg, fmt.Errorf("%v health check can't be used with UDP port %v", cfg.HealthCheck, port)
+		}
+	default:
+		return cfg, fmt.Errorf("unsupported protocol %q for port %v", protocol, port)
+	}
+
+	// with direct routing and tunneling the packets are not rewritten, so
+	// the backends receive them on the port of the service.
+	if cfg.Method != "NAT" {
+		for _, backend := range backends {
+			if backend.Port != port {
+				return cfg, fmt.Errorf("%v method requires the target port %v to be the same as port %v",
+					cfg.Method, backend.Port, port)
+			}
+		}
+	}
+
+	return cfg, nil
+}
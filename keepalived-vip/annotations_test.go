@@ -0,0 +1,157 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestLVSConfig(t *testing.T) {
+	testCases := []struct {
+		annotations map[string]string
+		expected    *lvsConfig
+	}{
+		{
+			map[string]string{},
+			&lvsConfig{Scheduler: "wlc", Method: "NAT", PersistenceTimeout: 1800, HealthCheckPath: "/"},
+		},
+		{
+			map[string]string{
+				ipvsScheduler:          "sh",
+				ipvsMethod:             "dr",
+				ipvsPersistenceTimeout: "0",
+				ipvsHealthCheck:        "http",
+				ipvsHealthCheckPath:    "/healthz",
+			},
+			&lvsConfig{Scheduler: "sh", Method: "DR", PersistenceTimeout: 0, HealthCheck: "HTTP", HealthCheckPath: "/healthz"},
+		},
+		{map[string]string{ipvsScheduler: "random"}, nil},
+		{map[string]string{ipvsMethod: "SNAT"}, nil},
+		{map[string]string{ipvsPersistenceTimeout: "-1"}, nil},
+		{map[string]string{ipvsPersistenceTimeout: "30m"}, nil},
+		{map[string]string{ipvsHealthCheck: "ICMP"}, nil},
+		{map[string]string{ipvsHealthCheckPath: "healthz"}, nil},
+		{map[string]string{ipvsHealthCheckPath: "/a }"}, nil},
+	}
+
+	for i, tc := range testCases {
+		cfg, err := serviceAnnotations(tc.annotations).lvsConfig()
+		if tc.expected == nil {
+			if err == nil {
+				t.Errorf("case %d: expected an error, got %+v", i, cfg)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(cfg, tc.expected) {
+			t.Errorf("case %d: expected %+v, got %+v", i, tc.expected, cfg)
+		}
+	}
+}
+
+func TestForPort(t *testing.T) {
+	backends := []service{{Ip: "10.2.0.5", Port: 8080}}
+	testCases := []struct {
+		cfg         lvsConfig
+		protocol    api.Protocol
+		port        int
+		healthCheck string
+		valid       bool
+	}{
+		{lvsConfig{Method: "NAT"}, api.ProtocolTCP, 80, "TCP", true},
+		{lvsConfig{Method: "NAT"}, api.ProtocolUDP, 53, "NONE", true},
+		{lvsConfig{Method: "NAT", HealthCheck: "HTTP"}, api.ProtocolTCP, 80, "HTTP", true},
+		{lvsConfig{Method: "NAT", HealthCheck: "TCP"}, api.ProtocolUDP, 53, "", false},
+		{lvsConfig{Method: "NAT"}, api.Protocol("SCTP"), 80, "", false},
+		{lvsConfig{Method: "DR"}, api.ProtocolTCP, 8080, "TCP", true},
+		{lvsConfig{Method: "DR"}, api.ProtocolTCP, 80, "", false},
+		{lvsConfig{Method: "TUN"}, api.ProtocolTCP, 80, "", false},
+	}
+
+	for i, tc := range testCases {
+		cfg, err := tc.cfg.forPort(tc.protocol, tc.port, backends)
+		if !tc.valid {
+			if err == nil {
+				t.Errorf("case %d: expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if cfg.HealthCheck != tc.healthCheck {
+			t.Errorf("case %d: expected health check %v, got %v", i, tc.healthCheck, cfg.HealthCheck)
+		}
+	}
+}
+
+func TestRenderServices(t *testing.T) {
+	k := &keepalived{iface: "eth0", ip: "10.4.0.3", nodes: []string{"10.4.0.3"}, instances: 1, routerIDBase: 50}
+	svcs := []vip{
+		{
+			Name:      "default/dns",
+			Ip:        "10.4.0.50",
+			Port:      53,
+			Protocol:  "UDP",
+			Backends:  []service{{Ip: "10.2.0.5", Port: 53}},
+			lvsConfig: lvsConfig{Scheduler: "sh", Method: "DR", HealthCheck: "NONE"},
+		},
+		{
+			Name:      "default/echoheaders",
+			Ip:        "10.4.0.51",
+			Port:      80,
+			Protocol:  "TCP",
+			Backends:  []service{{Ip: "10.2.0.6", Port: 8080}},
+			lvsConfig: lvsConfig{Scheduler: "wlc", Method: "NAT", PersistenceTimeout: 1800, HealthCheck: "HTTP", HealthCheckPath: "/healthz"},
+		},
+	}
+
+	var b bytes.Buffer
+	if err := k.render(&b, svcs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg := b.String()
+	if !strings.Contains(cfg, "nopreempt") {
+		t.Errorf("expected a single instance not to be preempted")
+	}
+	dns := cfg[strings.Index(cfg, "virtual_server 10.4.0.50 53"):strings.Index(cfg, "virtual_server 10.4.0.51 80")]
+	for _, s := range []string{"lvs_sched sh", "lvs_method DR", "protocol UDP", "real_server 10.2.0.5 53"} {
+		if !strings.Contains(dns, s) {
+			t.Errorf("expected %q in\n%v", s, dns)
+		}
+	}
+	for _, s := range []string{"persistence_timeout", "alpha", "TCP_CHECK", "HTTP_GET"} {
+		if strings.Contains(dns, s) {
+			t.Errorf("unexpected %q in\n%v", s, dns)
+		}
+	}
+	echo := cfg[strings.Index(cfg, "virtual_server 10.4.0.51 80"):]
+	for _, s := range []string{"lvs_sched wlc", "lvs_method NAT", "persistence_timeout 1800", "protocol TCP", "alpha", "HTTP_GET", "path /healthz", "connect_port 8080"} {
+		if !strings.Contains(echo, s) {
+			t.Errorf("expected %q in\n%v", s, echo)
+		}
+	}
+}
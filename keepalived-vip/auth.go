@@ -0,0 +1,112 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+const (
+	// authPassKey is the key of the VRRP password in the secret.
+	authPassKey = "password"
+	// maxAuthPassLength is the length keepalived truncates passwords to.
+	maxAuthPassLength = 8
+)
+
+// parseSecretName returns the namespace and name of a secret given as
+// namespace/name, or as name in defaultNamespace.
+func parseSecretName(value, defaultNamespace string) (string, string, error) {
+	parts := strings.Split(value, "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return defaultNamespace, parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("invalid secret %q, must be name or namespace/name", value)
+}
+
+// getAuthPass returns the VRRP password stored in a secret. If the secret
+// doesn't exist it is created with a random password: the first node
+// creates it and the others read it.
+func getAuthPass(client unversioned.SecretsNamespacer, namespace, name string) (string, error) {
+	secrets := client.Secrets(namespace)
+	secret, err := secrets.Get(name)
+	if errors.IsNotFound(err) {
+		var pass string
+		if pass, err = newAuthPass(); err != nil {
+			return "", err
+		}
+		secret, err = secrets.Create(&api.Secret{
+			ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       map[string][]byte{authPassKey: []byte(pass)},
+		})
+		if err == nil {
+			glog.Infof("created secret %v/%v with a new VRRP password", namespace, name)
+		} else if errors.IsAlreadyExists(err) {
+			secret, err = secrets.Get(name)
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting the VRRP password from secret %v/%v: %v", namespace, name, err)
+	}
+
+	pass := string(secret.Data[authPassKey])
+	if err := checkAuthPass(pass); err != nil {
+		return "", fmt.Errorf("invalid %v in secret %v/%v: %v", authPassKey, namespace, name, err)
+	}
+
+	return pass, nil
+}
+
+// checkAuthPass returns an error if pass can't be used in the keepalived
+// configuration.
+func checkAuthPass(pass string) error {
+	if pass == "" {
+		return fmt.Errorf("empty password")
+	}
+	if len(pass) > maxAuthPassLength {
+		return fmt.Errorf("the password is longer than %v characters", maxAuthPassLength)
+	}
+	for _, c := range pass {
+		if c <= ' ' || c > '~' || strings.ContainsRune(`"{}!#`, c) {
+			return fmt.Errorf("the password can only contain printable ASCII characters other than spaces and \"{}!#")
+		}
+	}
+
+	return nil
+}
+
+// newAuthPass returns a random password of maxAuthPassLength characters.
+func newAuthPass() (string, error) {
+	b := make([]byte, maxAuthPassLength*3/4)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating the VRRP password: %v", err)
+	}
+
+	return base64.URLEncoding.EncodeToString(b), nil
+}
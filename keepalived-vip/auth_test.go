@@ -0,0 +1,113 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func TestParseSecretName(t *testing.T) {
+	testCases := []struct {
+		value     string
+		namespace string
+		name      string
+		valid     bool
+	}{
+		{"vips", "kube-system", "vips", true},
+		{"default/vips", "default", "vips", true},
+		{"", "", "", false},
+		{"default/", "", "", false},
+		{"a/b/c", "", "", false},
+	}
+
+	for i, tc := range testCases {
+		namespace, name, err := parseSecretName(tc.value, "kube-system")
+		if !tc.valid {
+			if err == nil {
+				t.Errorf("case %d: expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		if namespace != tc.namespace || name != tc.name {
+			t.Errorf("case %d: expected %v/%v, got %v/%v", i, tc.namespace, tc.name, namespace, name)
+		}
+	}
+}
+
+func TestCheckAuthPass(t *testing.T) {
+	for _, pass := range []string{"secret", "a-B_c.9", "12345678"} {
+		if err := checkAuthPass(pass); err != nil {
+			t.Errorf("%q: unexpected error: %v", pass, err)
+		}
+	}
+	for _, pass := range []string{"", "123456789", "a b", "a}", "pass\n", "#secret"} {
+		if err := checkAuthPass(pass); err == nil {
+			t.Errorf("%q: expected an error", pass)
+		}
+	}
+}
+
+func TestGetAuthPass(t *testing.T) {
+	existing := &api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "vips", Namespace: "default"},
+		Data:       map[string][]byte{authPassKey: []byte("s3cret")},
+	}
+	pass, err := getAuthPass(testclient.NewSimpleFake(existing), "default", "vips")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pass != "s3cret" {
+		t.Errorf("expected s3cret, got %q", pass)
+	}
+
+	client := testclient.NewSimpleFake()
+	client.PrependReactor("create", "secrets", func(action testclient.Action) (bool, runtime.Object, error) {
+		return true, action.(testclient.CreateAction).GetObject(), nil
+	})
+	pass, err = getAuthPass(client, "default", "vips")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := checkAuthPass(pass); err != nil || len(pass) != maxAuthPassLength {
+		t.Errorf("invalid generated password %q: %v", pass, err)
+	}
+	created := false
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "create" && action.GetResource() == "secrets" {
+			created = true
+		}
+	}
+	if !created {
+		t.Errorf("expected the secret to be created, got %v", client.Actions())
+	}
+
+	invalid := &api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "vips", Namespace: "default"},
+		Data:       map[string][]byte{"pass": []byte("s3cret")},
+	}
+	if _, err := getAuthPass(testclient.NewSimpleFake(invalid), "default", "vips"); err == nil {
+		t.Errorf("expected an error without %v", authPassKey)
+	}
+}
@@ -18,7 +18,9 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
@@ -35,9 +37,14 @@ import (
 )
 
 const (
-	reloadQPS     = 10.0
-	resyncPeriod  = 10 * time.Second
-	ipvsPublicVIP = "k8s.io/public-vip"
+	reloadQPS    = 10.0
+	resyncPeriod = 10 * time.Second
+	// vipHolderPeriod is how often the ipvsVIPHolder annotations are updated
+	vipHolderPeriod = 5 * time.Second
+	ipvsPublicVIP   = "k8s.io/public-vip"
+	// ipvsVIPHolder is the IP address of the node holding the VIP of the
+	// service, set by that node.
+	ipvsVIPHolder = "k8s.io/vip-holder"
 )
 
 var (
@@ -59,6 +66,7 @@ type vip struct {
 	Port     int
 	Protocol string
 	Backends []service
+	lvsConfig
 }
 
 // ipvsControllerController watches the kubernetes api and adds/removes
@@ -73,6 +81,8 @@ type ipvsControllerController struct {
 	reloadRateLimiter util.RateLimiter
 	keepalived        *keepalived
 	reloadLock        *sync.Mutex
+	// heldVIPs are the VIPs held by this node in the last updateVIPHolders
+	heldVIPs map[string]bool
 }
 
 // getEndpoints returns a list of <endpoint ip>:<port> for a given service/target port combination.
@@ -110,50 +120,112 @@ func (ipvsc *ipvsControllerController) getEndpoints(
 	return
 }
 
-// getVIPs returns list of virtual IPs to expose.
-func (ipvsc *ipvsControllerController) getVIPs() []string {
-	vips := []string{}
+// getVIPs returns the virtual IPs to expose and the services using them.
+func (ipvsc *ipvsControllerController) getVIPs() map[string][]string {
+	vips := map[string][]string{}
 
 	services, _ := ipvsc.svcLister.List()
 	for _, s := range services.Items {
-		if externalIP, ok := s.GetAnnotations()[ipvsPublicVIP]; ok {
-			glog.Infof("Service %v contains public-vip annotation", s.Name)
-			vips = append(vips, externalIP)
+		if externalIP, ok := parseVIP(&s); ok {
+			key := fmt.Sprintf("%v/%v", s.Namespace, s.Name)
+			vips[externalIP] = append(vips[externalIP], key)
 		}
 	}
 
 	return vips
 }
 
+// parseVIP returns the virtual IP of a service, if it has a valid one.
+func parseVIP(s *api.Service) (string, bool) {
+	externalIP, ok := s.GetAnnotations()[ipvsPublicVIP]
+	if !ok {
+		return "", false
+	}
+
+	ip := net.ParseIP(externalIP)
+	if ip == nil {
+		glog.Errorf("Ignoring service %v/%v: invalid %v %q", s.Namespace, s.Name, ipvsPublicVIP, externalIP)
+		return "", false
+	}
+
+	return ip.String(), true
+}
+
 // getServices returns a list of services and their endpoints.
 func (ipvsc *ipvsControllerController) getServices() []vip {
 	svcs := []vip{}
 
 	services, _ := ipvsc.svcLister.List()
 	for _, s := range services.Items {
-		if externalIP, ok := s.GetAnnotations()[ipvsPublicVIP]; ok {
-			for _, servicePort := range s.Spec.Ports {
-				ep := ipvsc.getEndpoints(&s, &servicePort)
-				if len(ep) == 0 {
-					glog.Infof("No endpoints found for service %v, port %+v", s.Name, servicePort)
-					continue
-				}
+		externalIP, ok := parseVIP(&s)
+		if !ok {
+			continue
+		}
 
-				svcs = append(svcs, vip{
-					Name:     fmt.Sprintf("%v/%v", s.Namespace, s.Name),
-					Ip:       externalIP,
-					Port:     servicePort.Port,
-					Backends: ep,
-					Protocol: fmt.Sprintf("%v", servicePort.Protocol),
-				})
-				glog.Infof("Found service: %v", s.Name)
+		cfg, err := serviceAnnotations(s.GetAnnotations()).lvsConfig()
+		if err != nil {
+			glog.Errorf("Ignoring service %v/%v: %v", s.Namespace, s.Name, err)
+			continue
+		}
+
+		for _, servicePort := range s.Spec.Ports {
+			ep := ipvsc.getEndpoints(&s, &servicePort)
+			if len(ep) == 0 {
+				glog.Infof("No endpoints found for service %v, port %+v", s.Name, servicePort)
+				continue
+			}
+
+			portCfg, err := cfg.forPort(servicePort.Protocol, servicePort.Port, ep)
+			if err != nil {
+				glog.Errorf("Ignoring service %v/%v: %v", s.Namespace, s.Name, err)
+				continue
 			}
+
+			sort.Sort(serviceByIPPort(ep))
+			svcs = append(svcs, vip{
+				Name:      fmt.Sprintf("%v/%v", s.Namespace, s.Name),
+				Ip:        externalIP,
+				Port:      servicePort.Port,
+				Backends:  ep,
+				Protocol:  fmt.Sprintf("%v", servicePort.Protocol),
+				lvsConfig: portCfg,
+			})
+			glog.Infof("Found service: %v", s.Name)
 		}
 	}
 
+	// the services are listed in random order, sort them so the same
+	// services always give the same configuration and checksum
+	sort.Sort(vipByIPPort(svcs))
+
 	return svcs
 }
 
+type serviceByIPPort []service
+
+func (s serviceByIPPort) Len() int      { return len(s) }
+func (s serviceByIPPort) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s serviceByIPPort) Less(i, j int) bool {
+	if s[i].Ip != s[j].Ip {
+		return s[i].Ip < s[j].Ip
+	}
+	return s[i].Port < s[j].Port
+}
+
+type vipByIPPort []vip
+
+func (s vipByIPPort) Len() int      { return len(s) }
+func (s vipByIPPort) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s vipByIPPort) Less(i, j int) bool {
+	if s[i].Ip != s[j].Ip {
+		return s[i].Ip < s[j].Ip
+	}
+	if s[i].Port != s[j].Port {
+		return s[i].Port < s[j].Port
+	}
+	return s[i].Protocol < s[j].Protocol
+}
+
 // sync all services with the loadbalancer.
 func (ipvsc *ipvsControllerController) sync() error {
 	ipvsc.reloadRateLimiter.Accept()
@@ -166,17 +238,16 @@ func (ipvsc *ipvsControllerController) sync() error {
 		return errDeferredSync
 	}
 
-	err := ipvsc.keepalived.WriteCfg(ipvsc.getServices())
+	changed, err := ipvsc.keepalived.WriteCfg(ipvsc.getServices())
 	if err != nil {
 		return err
 	}
-
-	err = ipvsc.keepalived.Reload()
-	if err != nil {
-		return err
+	if !changed {
+		glog.V(2).Info("keepalived configuration unchanged, skipping reload")
+		return nil
 	}
 
-	return nil
+	return ipvsc.keepalived.Reload()
 }
 
 // worker handles the work queue.
@@ -193,7 +264,7 @@ func (ipvsc *ipvsControllerController) worker() {
 }
 
 // newIPVSController creates a new controller from the given config.
-func newIPVSController(kubeClient *unversioned.Client, namespace string, useUnicast bool) *ipvsControllerController {
+func newIPVSController(kubeClient *unversioned.Client, namespace string, useUnicast bool, vrrpInstances, routerIDBase int, authPass string) *ipvsControllerController {
 	ipvsc := ipvsControllerController{
 		client:            kubeClient,
 		queue:             workqueue.New(),
@@ -211,13 +282,17 @@ func newIPVSController(kubeClient *unversioned.Client, namespace string, useUnic
 	neighbors := getNodeNeighbors(nodeInfo, clusterNodes)
 
 	ipvsc.keepalived = &keepalived{
-		iface:      nodeInfo.iface,
-		ip:         nodeInfo.ip,
-		netmask:    nodeInfo.netmask,
-		nodes:      clusterNodes,
-		neighbors:  neighbors,
-		priority:   getNodePriority(nodeInfo.ip, clusterNodes),
-		useUnicast: useUnicast,
+		iface:        nodeInfo.iface,
+		ip:           nodeInfo.ip,
+		netmask:      nodeInfo.netmask,
+		nodes:        clusterNodes,
+		neighbors:    neighbors,
+		useUnicast:   useUnicast,
+		instances:    vrrpInstances,
+		routerIDBase: routerIDBase,
+		authPass:     authPass,
+		cfgPath:      keepalivedCfg,
+		configTest:   configTestCommand(),
 	}
 
 	enqueue := func(obj interface{}) {
@@ -234,7 +309,7 @@ func newIPVSController(kubeClient *unversioned.Client, namespace string, useUnic
 		AddFunc:    enqueue,
 		DeleteFunc: enqueue,
 		UpdateFunc: func(old, cur interface{}) {
-			if !reflect.DeepEqual(old, cur) {
+			if !reflect.DeepEqual(old, cur) && !onlyHolderChanged(old, cur) {
 				enqueue(cur)
 			}
 		},
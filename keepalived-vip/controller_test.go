@@ -0,0 +1,109 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// newTestInformer returns an informer listing objects once.
+func newTestInformer(list runtime.Object, objType runtime.Object) (cache.Store, *framework.Controller) {
+	return framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(api.ListOptions) (runtime.Object, error) {
+				return list, nil
+			},
+			WatchFunc: func(api.ListOptions) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		},
+		objType, 0, framework.ResourceEventHandlerFuncs{})
+}
+
+func TestSyncUnchangedServices(t *testing.T) {
+	services := &api.ServiceList{ListMeta: unversioned.ListMeta{ResourceVersion: "1"}}
+	endpoints := &api.EndpointsList{ListMeta: unversioned.ListMeta{ResourceVersion: "1"}}
+	for i := 0; i < 10; i++ {
+		meta := api.ObjectMeta{Name: fmt.Sprintf("svc-%v", i), Namespace: api.NamespaceDefault}
+		svc := api.Service{ObjectMeta: meta, Spec: api.ServiceSpec{
+			Ports: []api.ServicePort{{Port: 80, Protocol: api.ProtocolTCP, TargetPort: intstr.FromInt(8080)}},
+		}}
+		svc.Annotations = map[string]string{ipvsPublicVIP: fmt.Sprintf("10.4.0.%v", 50+i)}
+		services.Items = append(services.Items, svc)
+		endpoints.Items = append(endpoints.Items, api.Endpoints{ObjectMeta: meta, Subsets: []api.EndpointSubset{{
+			Addresses: []api.EndpointAddress{{IP: fmt.Sprintf("10.2.%v.3", i)}, {IP: fmt.Sprintf("10.2.%v.2", i)}},
+			Ports:     []api.EndpointPort{{Port: 8080}},
+		}}})
+	}
+
+	k, cleanup := newTestKeepalived(t)
+	defer cleanup()
+	// keepalived is this process, which catches the SIGHUP of the reloads
+	hup := make(chan os.Signal, 10)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	self, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	k.cmd = &exec.Cmd{Process: self}
+
+	ipvsc := &ipvsControllerController{
+		reloadRateLimiter: util.NewFakeRateLimiter(),
+		reloadLock:        &sync.Mutex{},
+		keepalived:        k,
+	}
+	ipvsc.svcLister.Store, ipvsc.svcController = newTestInformer(services, &api.Service{})
+	ipvsc.epLister.Store, ipvsc.epController = newTestInformer(endpoints, &api.Endpoints{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go ipvsc.svcController.Run(stop)
+	go ipvsc.epController.Run(stop)
+	for len(ipvsc.svcLister.Store.List()) < 10 || len(ipvsc.epLister.Store.List()) < 10 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := ipvsc.sync(); err != nil {
+			t.Fatalf("sync %d: unexpected error: %v", i, err)
+		}
+	}
+	if k.health.Reloads != 1 {
+		t.Errorf("expected 1 reload for unchanged services, got %v", k.health.Reloads)
+	}
+	select {
+	case <-hup:
+	case <-time.After(10 * time.Second):
+		t.Errorf("expected SIGHUP")
+	}
+}
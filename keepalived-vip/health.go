@@ -0,0 +1,98 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	reloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "keepalived_reloads_total",
+		Help: "Number of keepalived configuration reloads by result, success or failure.",
+	}, []string{"result"})
+
+	configHash = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "keepalived_config_info",
+		Help: "Always 1, with the sha1 of the current keepalived configuration as label.",
+	}, []string{"hash"})
+)
+
+func init() {
+	prometheus.MustRegister(reloads)
+	prometheus.MustRegister(configHash)
+}
+
+// health is the state of keepalived, served by healthHandler.
+type health struct {
+	Running    bool      `json:"running"`
+	ConfigHash string    `json:"configHash"`
+	Reloads    int       `json:"reloads"`
+	Failures   int       `json:"failures"`
+	LastReload time.Time `json:"lastReload"`
+	LastError  string    `json:"lastError,omitempty"`
+}
+
+// recordReload records the result of a reload, err is nil if it succeeded.
+// A configuration that fails the validation is a failed reload.
+func (k *keepalived) recordReload(err error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	k.health.LastReload = time.Now()
+	if err != nil {
+		k.health.Failures++
+		k.health.LastError = err.Error()
+		reloads.WithLabelValues("failure").Inc()
+		return
+	}
+	k.health.Reloads++
+	k.health.LastError = ""
+	reloads.WithLabelValues("success").Inc()
+}
+
+// setConfigHash records the hash of the configuration used by keepalived.
+// It is called after the configuration was reloaded.
+func (k *keepalived) setConfigHash(hash string) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	k.health.ConfigHash = hash
+	configHash.Reset()
+	configHash.WithLabelValues(hash).Set(1)
+}
+
+// healthHandler serves the state of keepalived as JSON. The status is 200
+// if keepalived is running and the last reload succeeded, 503 otherwise.
+func (k *keepalived) healthHandler(w http.ResponseWriter, r *http.Request) {
+	k.lock.Lock()
+	h := k.health
+	k.lock.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !h.Running || h.LastError != "" {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(h); err != nil {
+		glog.Errorf("error writing health: %v", err)
+	}
+}
@@ -17,12 +17,18 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
 	"text/template"
 
 	"github.com/golang/glog"
@@ -30,59 +36,65 @@ import (
 )
 
 const (
+	keepalivedCfg  = "/etc/keepalived/keepalived.conf"
 	keepalivedTmpl = `{{ $iface := .iface }}{{ $netmask := .netmask }}
-vrrp_sync_group VG_1 
-  group {
-    vips
-  }
-}
-
-vrrp_instance vips {
+{{ range $instance := .instances }}
+vrrp_instance {{ $instance.Name }} {
   state BACKUP
-  interface {{ $iface }}
-  virtual_router_id 50
-  priority {{ .priority }}
-  nopreempt
+  interface {{ $iface }}{{ if $instance.IPv6 }}
+  native_ipv6{{ end }}
+  virtual_router_id {{ $instance.RouterID }}
+  priority {{ $instance.Priority }}
+  {{ if $.preempt }}preempt_delay {{ $.preemptDelay }}{{ else }}nopreempt{{ end }}
   advert_int 1
 
   track_interface {
     {{ $iface }}
   }
 
-  {{ if .useUnicast }}
-  unicast_src_ip {{ .myIP }}
-  unicast_peer { {{ range .nodes }}
+  {{ if $instance.Unicast }}
+  unicast_src_ip {{ $.myIP }}
+  unicast_peer { {{ range $.nodes }}
     {{ . }}{{ end }}
   }
   {{ end }}
 
-  virtual_ipaddress { {{ range $i, $svc := .svcs }}
-    {{ $svc.Ip }}
-  {{ end }}}    
+  virtual_ipaddress { {{ range $instance.VIPs }}
+    {{ . }}
+  {{ end }}}
 
+  {{ if not $instance.IPv6 }}
   authentication {
     auth_type AH
-    auth_pass {{ .authPass }}
+    auth_pass {{ $.authPass }}
   }
+  {{ end }}
 }
+{{ end }}
 
 {{ range $i, $svc := .svcs }}
 virtual_server {{ $svc.Ip }} {{ $svc.Port }} {
   delay_loop 5
-  lvs_sched wlc
-  lvs_method NAT
-  persistence_timeout 1800
-  protocol TCP
-  #{{ $svc.Protocol }}
-  alpha
+  lvs_sched {{ $svc.Scheduler }}
+  lvs_method {{ $svc.Method }}
+  {{ if $svc.PersistenceTimeout }}persistence_timeout {{ $svc.PersistenceTimeout }}{{ end }}
+  protocol {{ $svc.Protocol }}
+  {{ if ne $svc.HealthCheck "NONE" }}alpha{{ end }}
 
   {{ range $j, $backend := $svc.Backends }}
   real_server {{ $backend.Ip }} {{ $backend.Port }} {
     weight 1
-    TCP_CHECK {
+    {{ if eq $svc.HealthCheck "TCP" }}TCP_CHECK {
       connect_port {{ $backend.Port }}
       connect_timeout 3
-    }
+    }{{ else if eq $svc.HealthCheck "HTTP" }}HTTP_GET {
+      url {
+        path {{ $svc.HealthCheckPath }}
+        status_code 200
+      }
+      connect_port {{ $backend.Port }}
+      connect_timeout 3
+    }{{ end }}
   }
 {{ end }}
 }    
@@ -91,24 +103,114 @@ virtual_server {{ $svc.Ip }} {{ $svc.Port }} {
 )
 
 type keepalived struct {
-	iface      string
-	ip         string
-	netmask    int
-	priority   int
-	nodes      []string
-	neighbors  []string
-	useUnicast bool
+	iface        string
+	ip           string
+	netmask      int
+	instances    int
+	routerIDBase int
+	nodes        []string
+	neighbors    []string
+	useUnicast   bool
+	// authPass is the VRRP password, the hash of the nodes if empty
+	authPass string
+	// cfgPath is the path of the configuration file
+	cfgPath string
+	// configTest is the command validating a configuration file, given as
+	// last argument, or nil if keepalived can't validate it
+	configTest []string
+
+	// lock protects the fields below, used by the health endpoint
+	lock    sync.Mutex
+	cmd     *exec.Cmd
+	cfgHash string
+	// rejectedHash is the hash of the last configuration that failed the
+	// validation, it is not validated again
+	rejectedHash string
+	health       health
 }
 
-// WriteCfg creates a new keepalived configuration file.
-// In case of an error with the generation it returns the error
-func (k *keepalived) WriteCfg(svcs []vip) error {
-	w, err := os.Create("/etc/keepalived/keepalived.conf")
+// WriteCfg creates a new keepalived configuration file and returns true if
+// it changed. The configuration is validated before replacing the file.
+// In case of an error with the generation or the validation it returns the
+// error and the file is not changed. A configuration that failed the
+// validation is skipped until it changes, it would fail again.
+func (k *keepalived) WriteCfg(svcs []vip) (bool, error) {
+	var b bytes.Buffer
+	if err := k.render(&b, svcs); err != nil {
+		return false, err
+	}
+
+	hash := checksum(b.Bytes())
+	k.lock.Lock()
+	unchanged := hash == k.cfgHash
+	rejected := hash == k.rejectedHash
+	k.lock.Unlock()
+	if unchanged {
+		return false, nil
+	}
+	if rejected {
+		glog.V(2).Infof("keepalived configuration %v was rejected, skipping it", hash)
+		return false, nil
+	}
+
+	tmp := k.cfgPath + ".new"
+	if err := ioutil.WriteFile(tmp, b.Bytes(), 0644); err != nil {
+		return false, err
+	}
+	if err := k.testCfg(tmp); err != nil {
+		os.Remove(tmp)
+		k.lock.Lock()
+		k.rejectedHash = hash
+		k.lock.Unlock()
+		k.recordReload(err)
+		return false, err
+	}
+	if err := os.Rename(tmp, k.cfgPath); err != nil {
+		return false, err
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.cfgHash = hash
+
+	return true, nil
+}
+
+// testCfg validates a configuration file with configTest.
+func (k *keepalived) testCfg(path string) error {
+	if len(k.configTest) == 0 {
+		return nil
+	}
+
+	args := append(append([]string{}, k.configTest[1:]...), path)
+	out, err := k8sexec.New().Command(k.configTest[0], args...).CombinedOutput()
 	if err != nil {
-		return err
+		return fmt.Errorf("invalid keepalived configuration: %v\n%s", err, out)
 	}
-	defer w.Close()
 
+	return nil
+}
+
+// configTestCommand returns the command validating a configuration file,
+// or nil if the version of keepalived doesn't support it.
+func configTestCommand() []string {
+	out, _ := k8sexec.New().Command("keepalived", "--help").CombinedOutput()
+	if !strings.Contains(string(out), "--config-test") {
+		return nil
+	}
+
+	return []string{"keepalived", "--config-test", "--use-file"}
+}
+
+// checksum returns the sha1 of a configuration.
+func checksum(cfg []byte) string {
+	h := sha1.New()
+	h.Write(cfg)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// render writes the keepalived configuration for the services to w.
+func (k *keepalived) render(w io.Writer, svcs []vip) error {
 	t, err := template.New("keepalived").Parse(keepalivedTmpl)
 	if err != nil {
 		return err
@@ -120,14 +222,22 @@ func (k *keepalived) WriteCfg(svcs []vip) error {
 	conf["netmask"] = k.netmask
 	conf["svcs"] = svcs
 	conf["nodes"] = k.neighbors
-	conf["priority"] = k.priority
-	// password to protect the access to the vrrp_instance group
-	conf["authPass"] = k.getSha()
+	conf["instances"] = k.getInstances(svcs)
 	conf["useUnicast"] = k.useUnicast
+	// with several instances the node with the highest priority must take
+	// its instances back after a restart to keep the VIPs spread
+	conf["preempt"] = k.instances > 1
+	conf["preemptDelay"] = preemptDelay
 
 	b, _ := json.Marshal(conf)
 	glog.Infof("%v", string(b))
 
+	// password to protect the access to the vrrp_instance group, not logged
+	conf["authPass"] = k.authPass
+	if k.authPass == "" {
+		conf["authPass"] = k.getSha()
+	}
+
 	return t.Execute(w, conf)
 }
 
@@ -138,29 +248,59 @@ func (k *keepalived) Start() {
 		"--dont-fork",
 		"--log-console",
 		"-D",
+		"--use-file", k.cfgPath,
 		"--pid", "/keepalived.pid")
 
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
-	if err := cmd.Start(); err != nil {
-		glog.Errorf("keepalived error: %v", err)
+	k.lock.Lock()
+	err := cmd.Start()
+	if err == nil {
+		k.cmd = cmd
+		k.health.Running = true
 	}
-
-	if err := cmd.Wait(); err != nil {
+	k.lock.Unlock()
+	if err != nil {
 		glog.Fatalf("keepalived error: %v", err)
 	}
+
+	err = cmd.Wait()
+	k.lock.Lock()
+	k.health.Running = false
+	k.lock.Unlock()
+	glog.Fatalf("keepalived error: keepalived exited: %v", err)
 }
 
-// Reload sends SIGHUP to keepalived to reload the configuration.
+// Reload sends SIGHUP to the keepalived process started by Start to reload
+// the configuration. Before it is started there is nothing to reload, it
+// reads the configuration when it starts. The hash of the configuration is
+// reported in the health and the metrics only if the reload succeeded.
 func (k *keepalived) Reload() error {
+	k.lock.Lock()
+	cmd := k.cmd
+	hash := k.cfgHash
+	k.lock.Unlock()
+	if cmd == nil {
+		glog.Info("keepalived is not running yet, skipping reload")
+		k.setConfigHash(hash)
+		return nil
+	}
+
 	glog.Info("reloading keepalived")
-	_, err := k8sexec.New().Command("killall", "-1", "keepalived").CombinedOutput()
+	err := cmd.Process.Signal(syscall.SIGHUP)
 	if err != nil {
-		return fmt.Errorf("error reloading keepalived: %v", err)
+		err = fmt.Errorf("error reloading keepalived: %v", err)
+		// write the configuration again in the next sync
+		k.lock.Lock()
+		k.cfgHash = ""
+		k.lock.Unlock()
+	} else {
+		k.setConfigHash(hash)
 	}
+	k.recordReload(err)
 
-	return nil
+	return err
 }
 
 // getSha returns a sha1 of the list of nodes in the cluster using the IP
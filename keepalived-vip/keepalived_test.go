@@ -0,0 +1,178 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func newTestKeepalived(t *testing.T) (*keepalived, func()) {
+	dir, err := ioutil.TempDir("", "keepalived")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	k := &keepalived{
+		iface:        "eth0",
+		ip:           "10.4.0.3",
+		nodes:        []string{"10.4.0.3"},
+		instances:    1,
+		routerIDBase: 50,
+		cfgPath:      filepath.Join(dir, "keepalived.conf"),
+	}
+	return k, func() { os.RemoveAll(dir) }
+}
+
+func TestWriteCfgChecksum(t *testing.T) {
+	k, cleanup := newTestKeepalived(t)
+	defer cleanup()
+	svcs := []vip{{Ip: "10.4.0.50", Port: 80, Protocol: "TCP", lvsConfig: lvsConfig{Scheduler: "wlc", Method: "NAT", HealthCheck: "TCP"}}}
+
+	testCases := []struct {
+		svcs    []vip
+		changed bool
+	}{
+		{svcs, true},
+		{svcs, false},
+		{append(svcs, vip{Ip: "10.4.0.51", Port: 53, Protocol: "UDP", lvsConfig: lvsConfig{Scheduler: "rr", Method: "NAT", HealthCheck: "NONE"}}), true},
+		{svcs, true},
+	}
+	for i, tc := range testCases {
+		changed, err := k.WriteCfg(tc.svcs)
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", i, err)
+		}
+		if changed != tc.changed {
+			t.Errorf("case %d: expected changed %v, got %v", i, tc.changed, changed)
+		}
+		cfg, err := ioutil.ReadFile(k.cfgPath)
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", i, err)
+		}
+		if hash := checksum(cfg); hash != k.cfgHash {
+			t.Errorf("case %d: expected hash %v, got %v", i, hash, k.cfgHash)
+		}
+	}
+}
+
+func TestWriteCfgValidation(t *testing.T) {
+	k, cleanup := newTestKeepalived(t)
+	defer cleanup()
+	svcs := []vip{{Ip: "10.4.0.50", Port: 80, Protocol: "TCP", lvsConfig: lvsConfig{Scheduler: "wlc", Method: "NAT", HealthCheck: "TCP"}}}
+
+	k.configTest = []string{"true"}
+	if changed, err := k.WriteCfg(svcs); err != nil || !changed {
+		t.Fatalf("expected a valid configuration, got %v, %v", changed, err)
+	}
+	valid, _ := ioutil.ReadFile(k.cfgPath)
+
+	k.configTest = []string{"sh", "-c", "echo bad config; exit 1", "sh"}
+	invalid := append(svcs, vip{Ip: "10.4.0.51", Port: 80, Protocol: "TCP"})
+	if _, err := k.WriteCfg(invalid); err == nil {
+		t.Fatalf("expected a validation error")
+	}
+	cfg, _ := ioutil.ReadFile(k.cfgPath)
+	if string(cfg) != string(valid) {
+		t.Errorf("expected the previous configuration to be kept")
+	}
+	if _, err := os.Stat(k.cfgPath + ".new"); !os.IsNotExist(err) {
+		t.Errorf("expected the invalid configuration to be removed, got %v", err)
+	}
+	if k.health.Failures != 1 || k.health.LastError == "" {
+		t.Errorf("expected a failed reload, got %+v", k.health)
+	}
+
+	// the rejected configuration is not validated again
+	if changed, err := k.WriteCfg(invalid); err != nil || changed {
+		t.Errorf("expected the rejected configuration to be skipped, got %v, %v", changed, err)
+	}
+	if k.health.Failures != 1 {
+		t.Errorf("expected a single failed reload, got %+v", k.health)
+	}
+}
+
+func TestReload(t *testing.T) {
+	k, cleanup := newTestKeepalived(t)
+	defer cleanup()
+
+	// nothing to reload before keepalived is started
+	if err := k.Reload(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("can't start a process: %v", err)
+	}
+	k.cmd = cmd
+	k.health.Running = true
+	k.cfgHash = "hash"
+	if err := k.Reload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cmd.Wait()
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); !ok || status.Signal() != syscall.SIGHUP {
+		t.Errorf("expected the process to get SIGHUP, got %v", cmd.ProcessState)
+	}
+	if k.health.Reloads != 1 || k.health.LastError != "" || k.health.ConfigHash != "hash" {
+		t.Errorf("expected a successful reload of hash, got %+v", k.health)
+	}
+
+	// the process is gone, the configuration must be written again and the
+	// previous one is still reported
+	k.cfgHash = "new"
+	if err := k.Reload(); err == nil {
+		t.Errorf("expected an error")
+	}
+	if k.cfgHash != "" || k.health.Failures != 1 || k.health.ConfigHash != "hash" {
+		t.Errorf("expected a failed reload of new, got %+v", k.health)
+	}
+}
+
+func TestHealthHandler(t *testing.T) {
+	testCases := []struct {
+		health health
+		code   int
+	}{
+		{health{Running: true, ConfigHash: "abc"}, http.StatusOK},
+		{health{Running: false}, http.StatusServiceUnavailable},
+		{health{Running: true, LastError: "invalid keepalived configuration"}, http.StatusServiceUnavailable},
+	}
+
+	for i, tc := range testCases {
+		k := &keepalived{health: tc.health}
+		w := httptest.NewRecorder()
+		k.healthHandler(w, &http.Request{})
+		if w.Code != tc.code {
+			t.Errorf("case %d: expected %v, got %v", i, tc.code, w.Code)
+		}
+		var h health
+		if err := json.Unmarshal(w.Body.Bytes(), &h); err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+		}
+		if h.ConfigHash != tc.health.ConfigHash || h.LastError != tc.health.LastError {
+			t.Errorf("case %d: expected %+v, got %+v", i, tc.health, h)
+		}
+	}
+}
@@ -17,12 +17,16 @@ limitations under the License.
 package main
 
 import (
+	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
 	flag "github.com/spf13/pflag"
 
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/unversioned"
 	kubectl_util "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/util"
@@ -39,11 +43,30 @@ var (
 	useUnicast = flags.Bool("use-unicast", false, `use unicast instead of multicast for communication
 		with other keepalived instances`)
 
+	vrrpInstances = flags.Int("vrrp-instances", 1, `number of VRRP instances the VIPs are spread across.
+		Each instance prefers a different node as master`)
+
+	routerIDBase = flags.Int("vrrp-router-id-base", defaultRouterIDBase, `virtual router ID of the first VRRP
+		instance, the others use the following IDs. If 0, it is derived from the namespace of the pod
+		(POD_NAMESPACE) and the namespace watched, to avoid clashes with other deployments in the network`)
+
+	vrrpSecret = flags.String("vrrp-secret", "", `secret (name or namespace/name) with the VRRP password in the
+		key "password", created with a random password if it doesn't exist. The namespace defaults to the one
+		of the pod (POD_NAMESPACE). If empty, the password is derived from the node IPs`)
+
+	statusPort = flags.Int("status-port", 8081, `port of the HTTP endpoints /status that reports the VIPs held
+		by this node, /healthz that reports the state of keepalived and /metrics. 0 disables them`)
+
 	// sysctl changes required by keepalived
 	sysctlAdjustments = map[string]int{
 		// allows processes to bind() to non-local IP addresses
 		"net/ipv4/ip_nonlocal_bind": 1,
 	}
+
+	// sysctl changes for IPv6 VIPs, not available in older kernels
+	optionalSysctlAdjustments = map[string]int{
+		"net/ipv6/ip_nonlocal_bind": 1,
+	}
 )
 
 func main() {
@@ -74,6 +97,28 @@ func main() {
 		namespace = ""
 	}
 
+	if *routerIDBase == 0 {
+		*routerIDBase = hashedRouterIDBase(os.Getenv("POD_NAMESPACE")+"/"+namespace, *vrrpInstances)
+	}
+	if err := checkRouterIDs(*routerIDBase, *vrrpInstances); err != nil {
+		glog.Fatalf("Terminating execution: %v", err)
+	}
+
+	authPass := ""
+	if *vrrpSecret != "" {
+		podNamespace := os.Getenv("POD_NAMESPACE")
+		if podNamespace == "" {
+			podNamespace = api.NamespaceDefault
+		}
+		secretNamespace, secretName, err := parseSecretName(*vrrpSecret, podNamespace)
+		if err != nil {
+			glog.Fatalf("Terminating execution: %v", err)
+		}
+		if authPass, err = getAuthPass(kubeClient, secretNamespace, secretName); err != nil {
+			glog.Fatalf("Terminating execution: %v", err)
+		}
+	}
+
 	err = loadIPVModule()
 	if err != nil {
 		glog.Fatalf("Terminating execution: %v", err)
@@ -88,10 +133,21 @@ func main() {
 	if *useUnicast {
 		glog.Info("keepalived will use unicast to sync the nodes")
 	}
-	ipvsc := newIPVSController(kubeClient, namespace, *useUnicast)
+	glog.Infof("using %v VRRP instances with router IDs from %v", *vrrpInstances, *routerIDBase)
+	ipvsc := newIPVSController(kubeClient, namespace, *useUnicast, *vrrpInstances, *routerIDBase, authPass)
 	go ipvsc.epController.Run(util.NeverStop)
 	go ipvsc.svcController.Run(util.NeverStop)
 	go util.Until(ipvsc.worker, time.Second, util.NeverStop)
+	go util.Until(ipvsc.updateVIPHolders, vipHolderPeriod, util.NeverStop)
+
+	if *statusPort > 0 {
+		http.HandleFunc("/status", ipvsc.statusHandler)
+		http.HandleFunc("/healthz", ipvsc.keepalived.healthHandler)
+		http.Handle("/metrics", prometheus.Handler())
+		go func() {
+			glog.Fatal(http.ListenAndServe(fmt.Sprintf(":%v", *statusPort), nil))
+		}()
+	}
 
 	time.Sleep(5 * time.Second)
 	glog.Info("starting keepalived to announce VIPs")
@@ -0,0 +1,217 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// vipStatus is the status of a VIP on this node.
+type vipStatus struct {
+	IP       string   `json:"ip"`
+	Services []string `json:"services"`
+	Held     bool     `json:"held"`
+}
+
+// nodeStatus is the status of the VIPs on this node, served by statusHandler.
+type nodeStatus struct {
+	Node string      `json:"node"`
+	VIPs []vipStatus `json:"vips"`
+}
+
+// heldVIPs returns the VIPs assigned to one of the local addresses, that
+// is, the VIPs announced by this node.
+func heldVIPs(vips []string, addrs []net.Addr) []string {
+	held := []string{}
+	for _, vip := range vips {
+		ip := net.ParseIP(vip)
+		for _, addr := range addrs {
+			if ipnet, ok := addr.(*net.IPNet); ok && ipnet.IP.Equal(ip) {
+				held = append(held, vip)
+				break
+			}
+		}
+	}
+
+	return held
+}
+
+// localVIPs returns the VIPs held by this node and the services using them.
+func (ipvsc *ipvsControllerController) localVIPs() (map[string][]string, []string, error) {
+	vips := ipvsc.getVIPs()
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return vips, nil, err
+	}
+
+	ips := []string{}
+	for ip := range vips {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+
+	return vips, heldVIPs(ips, addrs), nil
+}
+
+// status returns the status of the VIPs on this node.
+func (ipvsc *ipvsControllerController) status() (*nodeStatus, error) {
+	vips, held, err := ipvsc.localVIPs()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &nodeStatus{Node: ipvsc.keepalived.ip, VIPs: []vipStatus{}}
+	for ip, services := range vips {
+		sort.Strings(services)
+		status.VIPs = append(status.VIPs, vipStatus{
+			IP:       ip,
+			Services: services,
+			Held:     stringSlice(held).pos(ip) != -1,
+		})
+	}
+	sort.Sort(byIP(status.VIPs))
+
+	return status, nil
+}
+
+type byIP []vipStatus
+
+func (s byIP) Len() int           { return len(s) }
+func (s byIP) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byIP) Less(i, j int) bool { return s[i].IP < s[j].IP }
+
+// statusHandler serves the status of the VIPs on this node as JSON.
+func (ipvsc *ipvsControllerController) statusHandler(w http.ResponseWriter, r *http.Request) {
+	status, err := ipvsc.status()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		glog.Errorf("error writing status: %v", err)
+	}
+}
+
+// stillHeld returns the VIPs in held that were also held in the previous
+// update, and the held VIPs to use as previous in the next update.
+func stillHeld(previous map[string]bool, held []string) ([]string, map[string]bool) {
+	result := []string{}
+	current := map[string]bool{}
+	for _, ip := range held {
+		current[ip] = true
+		if previous[ip] {
+			result = append(result, ip)
+		}
+	}
+
+	return result, current
+}
+
+// updateVIPHolders sets the ipvsVIPHolder annotation of the services whose
+// VIP is held by this node to the IP address of the node. After a failover
+// the new holder updates the annotation. A VIP is only reported once it was
+// held for a whole vipHolderPeriod, because during a failover two nodes can
+// hold it for a moment and they would overwrite each other's annotation.
+func (ipvsc *ipvsControllerController) updateVIPHolders() {
+	vips, held, err := ipvsc.localVIPs()
+	if err != nil {
+		glog.Errorf("error getting the local addresses: %v", err)
+		return
+	}
+
+	held, ipvsc.heldVIPs = stillHeld(ipvsc.heldVIPs, held)
+	for _, ip := range held {
+		for _, key := range vips[ip] {
+			if err := ipvsc.setVIPHolder(key); err != nil {
+				glog.Warningf("error setting %v of service %v: %v", ipvsVIPHolder, key, err)
+			}
+		}
+	}
+}
+
+// setVIPHolder sets the ipvsVIPHolder annotation of a service to the IP
+// address of this node.
+func (ipvsc *ipvsControllerController) setVIPHolder(key string) error {
+	nodeIP := ipvsc.keepalived.ip
+	obj, exists, err := ipvsc.svcLister.Store.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	if obj.(*api.Service).Annotations[ipvsVIPHolder] == nodeIP {
+		return nil
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	svc, err := ipvsc.client.Services(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+	if svc.Annotations == nil {
+		svc.Annotations = map[string]string{}
+	}
+	svc.Annotations[ipvsVIPHolder] = nodeIP
+	if _, err := ipvsc.client.Services(namespace).Update(svc); err != nil {
+		return err
+	}
+	glog.Infof("service %v is served by this node (%v)", key, nodeIP)
+
+	return nil
+}
+
+// onlyHolderChanged returns true if old and cur are the same service except
+// for the ipvsVIPHolder annotation, which doesn't change the configuration.
+func onlyHolderChanged(old, cur interface{}) bool {
+	oldSvc, ok := old.(*api.Service)
+	if !ok {
+		return false
+	}
+	curSvc, ok := cur.(*api.Service)
+	if !ok {
+		return false
+	}
+
+	return reflect.DeepEqual(withoutHolder(oldSvc), withoutHolder(curSvc))
+}
+
+// withoutHolder returns a copy of the service without the ipvsVIPHolder
+// annotation and the resource version.
+func withoutHolder(svc *api.Service) api.Service {
+	c := *svc
+	c.ResourceVersion = ""
+	c.Annotations = map[string]string{}
+	for k, v := range svc.Annotations {
+		if k != ipvsVIPHolder {
+			c.Annotations[k] = v
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestHeldVIPs(t *testing.T) {
+	addrs := []net.Addr{
+		&net.IPNet{IP: net.ParseIP("10.4.0.4"), Mask: net.CIDRMask(24, 32)},
+		&net.IPNet{IP: net.ParseIP("10.4.0.50"), Mask: net.CIDRMask(32, 32)},
+		&net.IPNet{IP: net.ParseIP("2001:db8::50"), Mask: net.CIDRMask(128, 128)},
+	}
+	vips := []string{"10.4.0.50", "10.4.0.51", "2001:db8::50", "2001:db8::51"}
+
+	held := heldVIPs(vips, addrs)
+	expected := []string{"10.4.0.50", "2001:db8::50"}
+	if !reflect.DeepEqual(held, expected) {
+		t.Errorf("expected %v, got %v", expected, held)
+	}
+}
+
+func TestStillHeld(t *testing.T) {
+	updates := []struct {
+		held     []string
+		expected []string
+	}{
+		{[]string{"10.4.0.50", "10.4.0.51"}, []string{}},
+		{[]string{"10.4.0.50"}, []string{"10.4.0.50"}},
+		{[]string{"10.4.0.50", "10.4.0.51"}, []string{"10.4.0.50"}},
+		{[]string{"10.4.0.51"}, []string{"10.4.0.51"}},
+		{[]string{}, []string{}},
+		{[]string{"10.4.0.50"}, []string{}},
+	}
+	var previous map[string]bool
+	for i, update := range updates {
+		var result []string
+		result, previous = stillHeld(previous, update.held)
+		if !reflect.DeepEqual(result, update.expected) {
+			t.Errorf("case %d: expected %v, got %v", i, update.expected, result)
+		}
+	}
+}
+
+func TestOnlyHolderChanged(t *testing.T) {
+	svc := &api.Service{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "echoheaders",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{ipvsPublicVIP: "10.4.0.50"},
+		},
+	}
+	holder := *svc
+	holder.ResourceVersion = "2"
+	holder.Annotations = map[string]string{ipvsPublicVIP: "10.4.0.50", ipvsVIPHolder: "10.4.0.4"}
+	moved := holder
+	moved.ResourceVersion = "3"
+	moved.Annotations = map[string]string{ipvsPublicVIP: "10.4.0.51", ipvsVIPHolder: "10.4.0.4"}
+
+	if !onlyHolderChanged(svc, &holder) {
+		t.Errorf("expected a change of %v only", ipvsVIPHolder)
+	}
+	if onlyHolderChanged(&holder, &moved) {
+		t.Errorf("expected a change of %v", ipvsPublicVIP)
+	}
+	if onlyHolderChanged(&api.Endpoints{}, &api.Endpoints{}) {
+		t.Errorf("expected endpoints to be always synced")
+	}
+	if svc.Annotations[ipvsVIPHolder] != "" || holder.Annotations[ipvsVIPHolder] != "10.4.0.4" {
+		t.Errorf("the services must not be modified")
+	}
+}
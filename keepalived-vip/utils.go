@@ -17,7 +17,7 @@ limitations under the License.
 package main
 
 import (
-	"errors"
+	"fmt"
 	"net"
 	"os"
 	"regexp"
@@ -62,16 +62,20 @@ func getNodeInfo(nodes []string) (*nodeInfo, error) {
 // myIP returns the local IP address of this node comparing the
 // local addresses with the published by the cluster nodes
 func myIP(nodes []string) (string, error) {
-	var err error
 	for _, iface := range netInterfaces() {
-		ip, _, err := ipByInterface(iface.Name)
-		if err == nil && stringSlice(nodes).pos(ip) != -1 {
-			return ip, nil
+		addrs, err := addrsByInterface(iface.Name)
+		if err != nil {
+			glog.Warningf("error getting the addresses of %v: %v", iface.Name, err)
+			continue
+		}
+		for _, addr := range addrs {
+			if ip := addr.IP.String(); stringSlice(nodes).pos(ip) != -1 {
+				return ip, nil
+			}
 		}
 	}
 
-	glog.Errorf("error getting local IP: %v", err)
-	return "", err
+	return "", fmt.Errorf("no local address is the address of a node %v", nodes)
 }
 
 // netInterfaces returns a slice containing the local network interfaces
@@ -97,50 +101,67 @@ func netInterfaces() []net.Interface {
 // interfaceByIP returns the local network interface name that is using the
 // specified IP address. If no interface is found returns an empty string.
 func interfaceByIP(ip string) string {
-	for _, iface := range netInterfaces() {
-		ifaceIP, _, err := ipByInterface(iface.Name)
-		if err == nil && ip == ifaceIP {
-			return iface.Name
-		}
-	}
-
-	return ""
+	iface, _ := localAddr(ip)
+	return iface
 }
 
+// maskForLocalIP returns the prefix length of the local IP address, or the
+// length of a single address of its family if it isn't local.
 func maskForLocalIP(ip string) int {
+	if _, addr := localAddr(ip); addr != nil {
+		ones, _ := addr.Mask.Size()
+		return ones
+	}
+	if isIPv6(ip) {
+		return 128
+	}
+	return 32
+}
+
+// localAddr returns the name of the interface using ip and its address.
+func localAddr(ip string) (string, *net.IPNet) {
 	for _, iface := range netInterfaces() {
-		ifaceIP, mask, err := ipByInterface(iface.Name)
-		if err == nil && ip == ifaceIP {
-			return mask
+		addrs, err := addrsByInterface(iface.Name)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if addr.IP.Equal(net.ParseIP(ip)) {
+				return iface.Name, addr
+			}
 		}
 	}
 
-	return 32
+	return "", nil
 }
 
-func ipByInterface(name string) (string, int, error) {
+// addrsByInterface returns the IPv4 and IPv6 addresses of an interface,
+// excluding loopback and link-local addresses.
+func addrsByInterface(name string) ([]*net.IPNet, error) {
 	iface, err := net.InterfaceByName(name)
 	if err != nil {
-		return "", 32, err
+		return nil, err
 	}
 
 	addrs, err := iface.Addrs()
 	if err != nil {
-		return "", 32, err
+		return nil, err
 	}
 
+	ipnets := []*net.IPNet{}
 	for _, a := range addrs {
-		if ipnet, ok := a.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
-			if ipnet.IP.To4() != nil {
-				ip := ipnet.IP.String()
-				ones, _ := ipnet.Mask.Size()
-				mask := ones
-				return ip, mask, nil
-			}
+		if ipnet, ok := a.(*net.IPNet); ok && !ipnet.IP.IsLoopback() && !ipnet.IP.IsLinkLocalUnicast() {
+			ipnets = append(ipnets, ipnet)
 		}
 	}
 
-	return "", 32, errors.New("Found no IPv4 addresses.")
+	return ipnets, nil
+}
+
+// isIPv6 returns true if ip is an IPv6 address.
+func isIPv6(ip string) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && parsed.To4() == nil
 }
 
 type stringSlice []string
@@ -186,10 +207,24 @@ func getNodeNeighbors(nodeInfo *nodeInfo, clusterNodes []string) (neighbors []st
 	return
 }
 
-// getPriority returns the priority of one node using the
-// IP address as key. It starts in 100
-func getNodePriority(ip string, nodes []string) int {
-	return 100 + stringSlice(nodes).pos(ip)
+// getNodePriority returns the priority of one node in a VRRP instance
+// using the IP address as key. It starts in 100 and is rotated for each
+// instance, so every instance prefers a different node as master. With more
+// nodes than fit from 100 to maxPriority it starts lower.
+func getNodePriority(ip string, nodes []string, instance int) int {
+	base := 100
+	if base+len(nodes)-1 > maxPriority {
+		base = maxPriority - len(nodes) + 1
+	}
+
+	priority := base - 1
+	if pos := stringSlice(nodes).pos(ip); pos != -1 {
+		priority = base + (pos+instance)%len(nodes)
+	}
+	if priority < minPriority {
+		return minPriority
+	}
+	return priority
 }
 
 // loadIPVModule load module require to use keepalived
@@ -217,5 +252,11 @@ func changeSysctl() error {
 		}
 	}
 
+	for k, v := range optionalSysctlAdjustments {
+		if err := sysctl.SetSysctl(k, v); err != nil {
+			glog.Warningf("error changing %v: %v", k, err)
+		}
+	}
+
 	return nil
 }
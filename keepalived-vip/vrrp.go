@@ -0,0 +1,139 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+const (
+	// maxRouterID is the highest VRRP virtual router ID.
+	maxRouterID = 255
+	// defaultRouterIDBase is the router ID of the first VRRP instance.
+	defaultRouterIDBase = 50
+	// minPriority and maxPriority are the priorities keepalived accepts
+	// for a backup node.
+	minPriority = 1
+	maxPriority = 254
+	// preemptDelay is the number of seconds a node waits after starting
+	// before taking back the instances it prefers.
+	preemptDelay = 30
+)
+
+// vrrpInstance is a VRRP instance announcing a group of VIPs.
+type vrrpInstance struct {
+	Name     string
+	RouterID int
+	Priority int
+	IPv6     bool
+	Unicast  bool
+	VIPs     []string
+}
+
+// checkRouterIDs returns an error if the router IDs base, base+1, ...,
+// base+instances-1 are not valid VRRP router IDs.
+func checkRouterIDs(base, instances int) error {
+	if instances < 1 {
+		return fmt.Errorf("invalid number of VRRP instances %v, must be at least 1", instances)
+	}
+	if base < 1 || base+instances-1 > maxRouterID {
+		return fmt.Errorf("invalid VRRP router ID base %v, router IDs %v to %v must be between 1 and %v",
+			base, base, base+instances-1, maxRouterID)
+	}
+
+	return nil
+}
+
+// hashedRouterIDBase returns a router ID base derived from key, so that
+// deployments with different keys on the same network are likely to use
+// different router IDs.
+func hashedRouterIDBase(key string, instances int) int {
+	if instances < 1 || instances > maxRouterID {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return 1 + int(h.Sum32()%uint32(maxRouterID-instances+1))
+}
+
+// instanceForVIP returns the index of the VRRP instance announcing ip. The
+// index only depends on the IP address, so adding or removing a VIP doesn't
+// move the others.
+func instanceForVIP(ip string, instances int) int {
+	h := fnv.New32a()
+	h.Write([]byte(ip))
+	return int(h.Sum32() % uint32(instances))
+}
+
+// getInstances returns the VRRP instances announcing the VIPs of the
+// services. All the IPv4 instances are returned, even without VIPs, so that
+// the router IDs in use don't change with the services. IPv6 VIPs can't be
+// announced with IPv4 ones, they use IPv6 instances with the same router IDs,
+// which are only returned with VIPs.
+func (k *keepalived) getInstances(svcs []vip) []vrrpInstance {
+	v4 := k.newInstances(false)
+	v6 := k.newInstances(true)
+
+	added := map[string]bool{}
+	for _, svc := range svcs {
+		if added[svc.Ip] {
+			continue
+		}
+		added[svc.Ip] = true
+		instances := v4
+		if isIPv6(svc.Ip) {
+			instances = v6
+		}
+		i := instanceForVIP(svc.Ip, k.instances)
+		instances[i].VIPs = append(instances[i].VIPs, svc.Ip)
+	}
+
+	for _, instance := range v6 {
+		if len(instance.VIPs) > 0 {
+			v4 = append(v4, instance)
+		}
+	}
+	for _, instance := range v4 {
+		sort.Strings(instance.VIPs)
+	}
+
+	return v4
+}
+
+// newInstances returns the VRRP instances of one address family without VIPs.
+// Unicast is only used when the node address has the family of the instance.
+func (k *keepalived) newInstances(ipv6 bool) []vrrpInstance {
+	suffix := ""
+	if ipv6 {
+		suffix = "-ipv6"
+	}
+
+	instances := make([]vrrpInstance, k.instances)
+	for i := range instances {
+		instances[i] = vrrpInstance{
+			Name:     fmt.Sprintf("vips-%v%v", i, suffix),
+			RouterID: k.routerIDBase + i,
+			Priority: getNodePriority(k.ip, k.nodes, i),
+			IPv6:     ipv6,
+			Unicast:  k.useUnicast && isIPv6(k.ip) == ipv6,
+		}
+	}
+
+	return instances
+}
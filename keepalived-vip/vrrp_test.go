@@ -0,0 +1,206 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckRouterIDs(t *testing.T) {
+	testCases := []struct {
+		base      int
+		instances int
+		valid     bool
+	}{
+		{50, 1, true},
+		{1, 255, true},
+		{250, 6, true},
+		{250, 7, false},
+		{0, 1, false},
+		{256, 1, false},
+		{50, 0, false},
+	}
+
+	for i, tc := range testCases {
+		err := checkRouterIDs(tc.base, tc.instances)
+		if tc.valid && err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("case %d: expected an error", i)
+		}
+	}
+}
+
+func TestHashedRouterIDBase(t *testing.T) {
+	for _, instances := range []int{1, 4, 255} {
+		for _, key := range []string{"", "default/", "kube-system/default", "vips/"} {
+			base := hashedRouterIDBase(key, instances)
+			if err := checkRouterIDs(base, instances); err != nil {
+				t.Errorf("%q with %v instances: %v", key, instances, err)
+			}
+			if base != hashedRouterIDBase(key, instances) {
+				t.Errorf("%q with %v instances: base is not stable", key, instances)
+			}
+		}
+	}
+	if hashedRouterIDBase("default/", 4) == hashedRouterIDBase("kube-system/", 4) {
+		t.Errorf("expected different bases for different keys")
+	}
+}
+
+func TestNodePriorityRotation(t *testing.T) {
+	nodes := []string{"10.4.0.3", "10.4.0.4", "10.4.0.5"}
+	for i := 0; i < len(nodes); i++ {
+		priorities := map[int]bool{}
+		master := ""
+		for _, node := range nodes {
+			p := getNodePriority(node, nodes, i)
+			if priorities[p] {
+				t.Errorf("instance %v: duplicate priority %v", i, p)
+			}
+			priorities[p] = true
+			if p == 100+len(nodes)-1 {
+				master = node
+			}
+		}
+		expected := nodes[len(nodes)-1-i]
+		if master != expected {
+			t.Errorf("instance %v: expected %v to have the highest priority, got %v", i, expected, master)
+		}
+	}
+	if p := getNodePriority("10.4.0.9", nodes, 1); p != 99 {
+		t.Errorf("expected priority 99 for an unknown node, got %v", p)
+	}
+
+	for _, n := range []int{154, 155, 254, 300} {
+		many := []string{}
+		for i := 0; i < n; i++ {
+			many = append(many, fmt.Sprintf("10.4.%v.%v", i/256, i%256))
+		}
+		priorities := map[int]bool{}
+		for _, node := range many {
+			p := getNodePriority(node, many, 3)
+			if p < minPriority || p > maxPriority {
+				t.Errorf("%v nodes: priority %v out of range", n, p)
+			}
+			priorities[p] = true
+		}
+		if n <= maxPriority && len(priorities) != n {
+			t.Errorf("%v nodes: expected %v different priorities, got %v", n, n, len(priorities))
+		}
+	}
+}
+
+func TestGetInstances(t *testing.T) {
+	k := &keepalived{ip: "10.4.0.4", nodes: []string{"10.4.0.3", "10.4.0.4"}, instances: 4, routerIDBase: 60}
+	svcs := []vip{}
+	for i := 0; i < 40; i++ {
+		ip := fmt.Sprintf("10.4.1.%v", i)
+		// two ports of the same service use the same VIP
+		svcs = append(svcs, vip{Ip: ip, Port: 80}, vip{Ip: ip, Port: 443})
+	}
+
+	instances := k.getInstances(svcs)
+	if len(instances) != 4 {
+		t.Fatalf("expected 4 instances, got %v", len(instances))
+	}
+	total := 0
+	for i, instance := range instances {
+		if instance.RouterID != 60+i {
+			t.Errorf("instance %v: expected router ID %v, got %v", i, 60+i, instance.RouterID)
+		}
+		if instance.Priority != getNodePriority(k.ip, k.nodes, i) {
+			t.Errorf("instance %v: unexpected priority %v", i, instance.Priority)
+		}
+		if len(instance.VIPs) == 0 {
+			t.Errorf("instance %v: expected some of the 40 VIPs", i)
+		}
+		for _, ip := range instance.VIPs {
+			if instanceForVIP(ip, 4) != i {
+				t.Errorf("instance %v: unexpected VIP %v", i, ip)
+			}
+		}
+		total += len(instance.VIPs)
+	}
+	if total != 40 {
+		t.Errorf("expected 40 VIPs, got %v", total)
+	}
+
+	var b bytes.Buffer
+	if err := k.render(&b, svcs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg := b.String()
+	if strings.Contains(cfg, "nopreempt") || !strings.Contains(cfg, "preempt_delay") {
+		t.Errorf("expected the instances to be preempted with a delay")
+	}
+	if strings.Contains(cfg, "vrrp_sync_group") {
+		t.Errorf("unexpected sync group, the instances must fail over independently")
+	}
+	for i := 0; i < 4; i++ {
+		if !strings.Contains(cfg, fmt.Sprintf("virtual_router_id %v", 60+i)) {
+			t.Errorf("expected router ID %v in\n%v", 60+i, cfg)
+		}
+	}
+}
+
+func TestGetInstancesIPv6(t *testing.T) {
+	k := &keepalived{ip: "10.4.0.4", nodes: []string{"10.4.0.4"}, instances: 2, routerIDBase: 50, useUnicast: true}
+	svcs := []vip{{Ip: "10.4.0.50"}, {Ip: "2001:db8::50"}}
+
+	instances := k.getInstances(svcs)
+	v6 := []vrrpInstance{}
+	for _, instance := range instances {
+		if instance.IPv6 {
+			v6 = append(v6, instance)
+			continue
+		}
+		if !instance.Unicast {
+			t.Errorf("%v: expected unicast with an IPv4 node", instance.Name)
+		}
+		for _, ip := range instance.VIPs {
+			if isIPv6(ip) {
+				t.Errorf("%v: unexpected IPv6 VIP %v", instance.Name, ip)
+			}
+		}
+	}
+	if len(v6) != 1 {
+		t.Fatalf("expected one IPv6 instance, got %+v", v6)
+	}
+	if !reflect.DeepEqual(v6[0].VIPs, []string{"2001:db8::50"}) || v6[0].Unicast {
+		t.Errorf("unexpected IPv6 instance %+v", v6[0])
+	}
+	if v6[0].RouterID != 50+instanceForVIP("2001:db8::50", 2) {
+		t.Errorf("unexpected router ID %v", v6[0].RouterID)
+	}
+
+	var b bytes.Buffer
+	if err := k.render(&b, svcs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg := b.String()
+	v6Cfg := cfg[strings.Index(cfg, "vrrp_instance "+v6[0].Name):]
+	v6Cfg = v6Cfg[:strings.Index(v6Cfg, "\n}")]
+	if !strings.Contains(v6Cfg, "native_ipv6") || strings.Contains(v6Cfg, "authentication") || strings.Contains(v6Cfg, "unicast_peer") {
+		t.Errorf("unexpected IPv6 instance\n%v", v6Cfg)
+	}
+}
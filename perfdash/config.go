@@ -0,0 +1,93 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Config lists the jobs tracked by perfdash.
+type Config struct {
+	Jobs []JobConfig `json:"jobs"`
+}
+
+// JobConfig describes a Jenkins job and the data extracted from its builds.
+type JobConfig struct {
+	// Name of the Jenkins job
+	Name string `json:"name"`
+	// Tests maps the description of a test (e.g. "should allow starting 30 pods per node") to the
+	// name shown in the dashboard. Tests not listed are shown with their description.
+	Tests map[string]string `json:"tests"`
+	// Metrics lists the data extracted from the tests, all of them if empty.
+	Metrics []string `json:"metrics"`
+}
+
+// TestName returns the name shown for the test with the given description.
+func (j *JobConfig) TestName(description string) string {
+	if name, ok := j.Tests[description]; ok {
+		return name
+	}
+	return description
+}
+
+// DefaultConfig tracks the scalability job only.
+func DefaultConfig() *Config {
+	return &Config{
+		Jobs: []JobConfig{
+			{
+				Name: "kubernetes-e2e-gce-scalability",
+				Tests: map[string]string{
+					"should allow starting 30 pods per node":    "Density",
+					"should be able to handle 30 pods per node": "Load",
+				},
+				Metrics: allMetrics,
+			},
+		},
+	}
+}
+
+// LoadConfig reads a JSON config file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("error parsing %v: %v", path, err)
+	}
+	if len(config.Jobs) == 0 {
+		return nil, fmt.Errorf("no jobs in %v", path)
+	}
+	for i := range config.Jobs {
+		job := &config.Jobs[i]
+		if job.Name == "" {
+			return nil, fmt.Errorf("job without name in %v", path)
+		}
+		if len(job.Metrics) == 0 {
+			job.Metrics = allMetrics
+		}
+		for _, metric := range job.Metrics {
+			if _, ok := metricParsers[metric]; !ok {
+				return nil, fmt.Errorf("unknown metric %q for job %v in %v, expected one of %v", metric, job.Name, path, allMetrics)
+			}
+		}
+	}
+	return config, nil
+}
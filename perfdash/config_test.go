@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadExampleConfig(t *testing.T) {
+	config, err := LoadConfig("example-config.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %+v", config.Jobs)
+	}
+	if !reflect.DeepEqual(config.Jobs[0].Metrics, allMetrics) || !reflect.DeepEqual(config.Jobs[1].Metrics, []string{APICallLatencyMetric}) {
+		t.Errorf("unexpected metrics %v and %v", config.Jobs[0].Metrics, config.Jobs[1].Metrics)
+	}
+	job := config.Jobs[0]
+	if name := job.TestName("should allow starting 30 pods per node"); name != "Density" {
+		t.Errorf("expected Density, got %v", name)
+	}
+	// tests that aren't listed keep their description
+	if name := job.TestName("should scale to 100 nodes"); name != "should scale to 100 nodes" {
+		t.Errorf("expected the description, got %v", name)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "perfdash")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+
+	cases := []struct {
+		data  string
+		valid bool
+	}{
+		{`{"jobs": [{"name": "job"}]}`, true},
+		{`{"jobs": [{"name": "job", "metrics": ["ResourceUsage"]}]}`, true},
+		{`{"jobs": []}`, false},
+		{`{"jobs": [{"metrics": ["APICallLatency"]}]}`, false},
+		{`{"jobs": [{"name": "job", "metrics": ["Latency"]}]}`, false},
+		{`{"jobs": [`, false},
+	}
+	for i, test := range cases {
+		if err := ioutil.WriteFile(path, []byte(test.data), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		config, err := LoadConfig(path)
+		if (err == nil) != test.valid {
+			t.Errorf("case %d: expected valid %v, got %v", i, test.valid, err)
+			continue
+		}
+		// all the metrics are extracted by default
+		if i == 0 && !reflect.DeepEqual(config.Jobs[0].Metrics, allMetrics) {
+			t.Errorf("case %d: expected all the metrics, got %v", i, config.Jobs[0].Metrics)
+		}
+	}
+	if _, err := LoadConfig(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("expected error loading a missing file")
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// APICallLatencyMetric is the latency of the API calls, by resource and verb.
+	APICallLatencyMetric = "APICallLatency"
+	// ResourceUsageMetric is the usage of the system containers, taken from the
+	// ResourceUsageSummary. The resource is the kind of container and the verb is "cpu" (in cores)
+	// or "memory" (in MB).
+	ResourceUsageMetric = "ResourceUsage"
+	// E2EMetricsMetric are the metrics of the components, taken from the MetricsForE2E summary.
+	// The resource is the component and the name of the metric and the verb are its labels.
+	E2EMetricsMetric = "E2EMetrics"
+)
+
+var allMetrics = []string{APICallLatencyMetric, ResourceUsageMetric, E2EMetricsMetric}
+
+// metricParser extracts a metric from the JSON printed after the line containing marker.
+type metricParser struct {
+	marker string
+	parse  func(data []byte) (ResourceToHistogram, error)
+}
+
+var metricParsers = map[string]metricParser{
+	APICallLatencyMetric: {marker: "API calls latencies", parse: parseAPICallLatency},
+	ResourceUsageMetric:  {marker: "ResourceUsageSummary JSON", parse: parseResourceUsage},
+	E2EMetricsMetric:     {marker: "MetricsForE2E JSON", parse: parseE2EMetrics},
+}
+
+func parseAPICallLatency(data []byte) (ResourceToHistogram, error) {
+	obj := LatencyData{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	result := ResourceToHistogram{}
+	for _, call := range obj.APICalls {
+		result[call.Resource] = append(result[call.Resource], call)
+	}
+	return result, nil
+}
+
+// containerSummary is the usage of a container in the ResourceUsageSummary of the e2e tests.
+type containerSummary struct {
+	Name string
+	Cpu  float64
+	Mem  int64
+}
+
+// parseResourceUsage extracts the usage of each kind of container, e.g. "kube-proxy". The usage
+// of all the containers of a kind is aggregated with max.
+func parseResourceUsage(data []byte) (ResourceToHistogram, error) {
+	summary := map[string][]containerSummary{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, err
+	}
+	cpu := map[string]Histogram{}
+	memory := map[string]Histogram{}
+	for percentile, containers := range summary {
+		bucket := "Perc" + percentile
+		for _, container := range containers {
+			kind := container.Name[strings.LastIndex(container.Name, "/")+1:]
+			if _, ok := cpu[kind]; !ok {
+				cpu[kind] = Histogram{}
+				memory[kind] = Histogram{}
+			}
+			maxInto(cpu[kind], bucket, container.Cpu)
+			maxInto(memory[kind], bucket, float64(container.Mem)/(1024*1024))
+		}
+	}
+	result := ResourceToHistogram{}
+	for kind := range cpu {
+		result[kind] = []APICallLatency{
+			{Resource: kind, Verb: "cpu", Latency: cpu[kind]},
+			{Resource: kind, Verb: "memory", Latency: memory[kind]},
+		}
+	}
+	return result, nil
+}
+
+// sample is a single value of a metric, as printed by the prometheus client.
+type sample struct {
+	Metric map[string]string `json:"metric"`
+	// Value is a pair of timestamp and value, the value is a string.
+	Value []interface{} `json:"value"`
+}
+
+// e2eMetrics is the MetricsForE2E summary of the e2e tests.
+type e2eMetrics struct {
+	ApiServerMetrics         map[string][]sample
+	ControllerManagerMetrics map[string][]sample
+	KubeletMetrics           map[string]map[string][]sample
+	SchedulerMetrics         map[string][]sample
+}
+
+// parseE2EMetrics extracts the metrics of every component. The resource of a metric is the
+// component and its name, e.g. "apiserver/apiserver_request_count", and the verb its labels except
+// "quantile", which is used as the bucket of the histogram (e.g. 0.9 -> "Perc90"). Values without
+// quantile use the "value" bucket. Kubelet metrics are aggregated with max over the nodes.
+func parseE2EMetrics(data []byte) (ResourceToHistogram, error) {
+	obj := e2eMetrics{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	histograms := map[string]map[string]Histogram{}
+	add := func(component string, metrics map[string][]sample) {
+		for name, samples := range metrics {
+			resource := component + "/" + name
+			for _, s := range samples {
+				value, err := s.value()
+				if err != nil {
+					continue
+				}
+				labels, bucket := s.labels()
+				if _, ok := histograms[resource]; !ok {
+					histograms[resource] = map[string]Histogram{}
+				}
+				if _, ok := histograms[resource][labels]; !ok {
+					histograms[resource][labels] = Histogram{}
+				}
+				maxInto(histograms[resource][labels], bucket, value)
+			}
+		}
+	}
+	add("apiserver", obj.ApiServerMetrics)
+	add("controller-manager", obj.ControllerManagerMetrics)
+	add("scheduler", obj.SchedulerMetrics)
+	for _, metrics := range obj.KubeletMetrics {
+		add("kubelet", metrics)
+	}
+
+	result := ResourceToHistogram{}
+	for resource, byLabels := range histograms {
+		for labels, hist := range byLabels {
+			result[resource] = append(result[resource], APICallLatency{Resource: resource, Verb: labels, Latency: hist})
+		}
+	}
+	return result, nil
+}
+
+// value returns the value of the sample. NaN, e.g. the quantiles of an empty summary, is an
+// error as it can't be stored as JSON.
+func (s *sample) value() (float64, error) {
+	if len(s.Value) != 2 {
+		return 0, fmt.Errorf("unexpected value %v", s.Value)
+	}
+	str, ok := s.Value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v", s.Value)
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err == nil && math.IsNaN(value) {
+		return 0, fmt.Errorf("unexpected value %v", s.Value)
+	}
+	return value, err
+}
+
+// labels returns the labels of the sample as "name=value,..." and the bucket of the value.
+func (s *sample) labels() (string, string) {
+	bucket := "value"
+	labels := []string{}
+	for k, v := range s.Metric {
+		switch k {
+		case "__name__":
+		case "quantile":
+			if q, err := strconv.ParseFloat(v, 64); err == nil {
+				bucket = fmt.Sprintf("Perc%g", q*100)
+			}
+		default:
+			labels = append(labels, k+"="+v)
+		}
+	}
+	if len(labels) == 0 {
+		return "-", bucket
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ","), bucket
+}
+
+// maxInto sets the bucket of the histogram to value if it is bigger than the current one.
+func maxInto(hist Histogram, bucket string, value float64) {
+	if current, ok := hist[bucket]; !ok || value > current {
+		hist[bucket] = value
+	}
+}
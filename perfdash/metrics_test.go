@@ -0,0 +1,145 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// byVerb sorts the values of a resource to compare them.
+type byVerb []APICallLatency
+
+func (a byVerb) Len() int           { return len(a) }
+func (a byVerb) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byVerb) Less(i, j int) bool { return a[i].Verb < a[j].Verb }
+
+func sortVerbs(result ResourceToHistogram) ResourceToHistogram {
+	for _, calls := range result {
+		sort.Sort(byVerb(calls))
+	}
+	return result
+}
+
+func TestParseAPICallLatency(t *testing.T) {
+	data := `{"apicalls": [
+		{"resource": "pods", "verb": "GET", "latency": {"Perc50": 1, "Perc99": 5}},
+		{"resource": "pods", "verb": "LIST", "latency": {"Perc99": 30}},
+		{"resource": "nodes", "verb": "PUT", "latency": {"Perc99": 2}}
+	]}`
+	expected := ResourceToHistogram{
+		"pods": []APICallLatency{
+			{Resource: "pods", Verb: "GET", Latency: Histogram{"Perc50": 1, "Perc99": 5}},
+			{Resource: "pods", Verb: "LIST", Latency: Histogram{"Perc99": 30}},
+		},
+		"nodes": []APICallLatency{
+			{Resource: "nodes", Verb: "PUT", Latency: Histogram{"Perc99": 2}},
+		},
+	}
+	result, err := parseAPICallLatency([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v, got %+v", expected, result)
+	}
+	if _, err := parseAPICallLatency([]byte("{")); err == nil {
+		t.Errorf("expected error parsing invalid JSON")
+	}
+}
+
+func TestParseResourceUsage(t *testing.T) {
+	// the usage of the containers of a kind is aggregated with max
+	data := `{
+		"50": [
+			{"Name": "node-1/kube-proxy", "Cpu": 0.1, "Mem": 10485760},
+			{"Name": "node-2/kube-proxy", "Cpu": 0.3, "Mem": 5242880},
+			{"Name": "master/kube-apiserver", "Cpu": 1.5, "Mem": 104857600}
+		],
+		"99": [
+			{"Name": "node-1/kube-proxy", "Cpu": 0.5, "Mem": 20971520}
+		]
+	}`
+	expected := ResourceToHistogram{
+		"kube-proxy": []APICallLatency{
+			{Resource: "kube-proxy", Verb: "cpu", Latency: Histogram{"Perc50": 0.3, "Perc99": 0.5}},
+			{Resource: "kube-proxy", Verb: "memory", Latency: Histogram{"Perc50": 10, "Perc99": 20}},
+		},
+		"kube-apiserver": []APICallLatency{
+			{Resource: "kube-apiserver", Verb: "cpu", Latency: Histogram{"Perc50": 1.5}},
+			{Resource: "kube-apiserver", Verb: "memory", Latency: Histogram{"Perc50": 100}},
+		},
+	}
+	result, err := parseResourceUsage([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestParseE2EMetrics(t *testing.T) {
+	// apiserver, controller-manager and scheduler have a metric with the same name, and the
+	// kubelet metrics are aggregated with max over the nodes
+	data := `{
+		"ApiServerMetrics": {
+			"go_goroutines": [{"metric": {"__name__": "go_goroutines"}, "value": [1459864200, "500"]}],
+			"apiserver_request_latencies_summary": [
+				{"metric": {"resource": "pods", "verb": "GET", "quantile": "0.5"}, "value": [1459864200, "1000"]},
+				{"metric": {"resource": "pods", "verb": "GET", "quantile": "0.99"}, "value": [1459864200, "9000"]},
+				{"metric": {"resource": "pods", "verb": "GET", "quantile": "0.9"}, "value": [1459864200, "NaN"]},
+				{"metric": {"resource": "pods", "verb": "LIST"}, "value": [1459864200]}
+			]
+		},
+		"ControllerManagerMetrics": {
+			"go_goroutines": [{"metric": {"__name__": "go_goroutines"}, "value": [1459864200, "100"]}]
+		},
+		"SchedulerMetrics": {
+			"go_goroutines": [{"metric": {"__name__": "go_goroutines"}, "value": [1459864200, "50"]}]
+		},
+		"KubeletMetrics": {
+			"node-1": {"go_goroutines": [{"metric": {"__name__": "go_goroutines"}, "value": [1459864200, "30"]}]},
+			"node-2": {"go_goroutines": [{"metric": {"__name__": "go_goroutines"}, "value": [1459864200, "40"]}]}
+		}
+	}`
+	expected := ResourceToHistogram{
+		"apiserver/go_goroutines": []APICallLatency{
+			{Resource: "apiserver/go_goroutines", Verb: "-", Latency: Histogram{"value": 500}},
+		},
+		"apiserver/apiserver_request_latencies_summary": []APICallLatency{
+			{Resource: "apiserver/apiserver_request_latencies_summary", Verb: "resource=pods,verb=GET", Latency: Histogram{"Perc50": 1000, "Perc99": 9000}},
+		},
+		"controller-manager/go_goroutines": []APICallLatency{
+			{Resource: "controller-manager/go_goroutines", Verb: "-", Latency: Histogram{"value": 100}},
+		},
+		"scheduler/go_goroutines": []APICallLatency{
+			{Resource: "scheduler/go_goroutines", Verb: "-", Latency: Histogram{"value": 50}},
+		},
+		"kubelet/go_goroutines": []APICallLatency{
+			{Resource: "kubelet/go_goroutines", Verb: "-", Latency: Histogram{"value": 40}},
+		},
+	}
+	result, err := parseE2EMetrics([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result = sortVerbs(result); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v, got %+v", expected, result)
+	}
+}
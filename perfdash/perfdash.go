@@ -18,16 +18,20 @@ package main
 
 import (
 	"bufio"
-	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"k8s.io/contrib/compare/src/jsonreader"
 	// TODO: move this somewhere central
 	"k8s.io/contrib/mungegithub/mungers/jenkins"
 	"k8s.io/kubernetes/pkg/util/sets"
@@ -60,8 +64,94 @@ type BuildLatencyData map[string]ResourceToHistogram
 // TestToBuildData is a map from test name to BuildLatencyData
 type TestToBuildData map[string]BuildLatencyData
 
-func (buildLatency *TestToBuildData) ServeHTTP(res http.ResponseWriter, req *http.Request) {
-	data, err := json.Marshal(buildLatency)
+// MetricToTestHistogram is a map from metric name (e.g. "APICallLatency") to the data of a build
+type MetricToTestHistogram map[string]TestToHistogram
+
+// MetricToTestBuildData is a map from metric name to the data of all the builds of a job
+type MetricToTestBuildData map[string]TestToBuildData
+
+// JobToMetricData is a map from job name to MetricToTestBuildData
+type JobToMetricData map[string]MetricToTestBuildData
+
+// DataServer serves the data of the jobs as JSON, it is updated in the background.
+type DataServer struct {
+	lock        sync.RWMutex
+	data        JobToMetricData
+	regressions map[string][]Regression
+	// defaultJob is served when the request doesn't select one
+	defaultJob string
+}
+
+// SetJobData replaces the data served for a job.
+func (d *DataServer) SetJobData(job string, data MetricToTestBuildData) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.data == nil {
+		d.data = JobToMetricData{}
+	}
+	d.data[job] = data
+}
+
+// SetRegressions replaces the regressions of a job and returns the ones that weren't known.
+func (d *DataServer) SetRegressions(job string, regressions []Regression) []Regression {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.regressions == nil {
+		d.regressions = map[string][]Regression{}
+	}
+	result := newRegressions(d.regressions[job], regressions)
+	d.regressions[job] = regressions
+	return result
+}
+
+// ServeRegressions serves the current regressions of all the jobs.
+func (d *DataServer) ServeRegressions(res http.ResponseWriter, req *http.Request) {
+	regressions := []Regression{}
+	d.lock.RLock()
+	for _, r := range d.regressions {
+		regressions = append(regressions, r...)
+	}
+	d.lock.RUnlock()
+	sort.Sort(regressionArr(regressions))
+	serveJSON(res, regressions)
+}
+
+// getData returns the data of the job and metric, by default the first job and the
+// APICallLatency metric.
+func (d *DataServer) getData(job, metric string) TestToBuildData {
+	if job == "" {
+		job = d.defaultJob
+	}
+	if metric == "" {
+		metric = APICallLatencyMetric
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	data, ok := d.data[job][metric]
+	if !ok {
+		// The data of the job may not be fetched yet.
+		return TestToBuildData{}
+	}
+	return data
+}
+
+// ServeHTTP serves the TestToBuildData of the job and metric selected by the "job" and "metric"
+// parameters, by default the first job and the APICallLatency metric.
+func (d *DataServer) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	serveJSON(res, d.getData(req.URL.Query().Get("job"), req.URL.Query().Get("metric")))
+}
+
+// ServeJobs serves a map from job name to the metrics extracted from its builds.
+func (d *DataServer) ServeJobs(res http.ResponseWriter, req *http.Request) {
+	jobs := map[string][]string{}
+	for _, job := range config.Jobs {
+		jobs[job.Name] = job.Metrics
+	}
+	serveJSON(res, jobs)
+}
+
+func serveJSON(res http.ResponseWriter, obj interface{}) {
+	data, err := json.Marshal(obj)
 	if err != nil {
 		res.Header().Set("Content-type", "text/html")
 		res.WriteHeader(http.StatusInternalServerError)
@@ -79,133 +169,283 @@ const (
 	processing = iota
 )
 
-var descriptionToName = map[string]string{
-	"should allow starting 30 pods per node":    "Density",
-	"should be able to handle 30 pods per node": "Load",
-}
-
-// Assumes that *resources* and *methods* are already initialized.
-func parseTestOutput(scanner *bufio.Scanner, buildNumber int, resources sets.String, methods sets.String) TestToHistogram {
-	buff := &bytes.Buffer{}
-	hist := TestToHistogram{}
+// maxLogLineSize is the length of the longest line of a build log that can be read, the summaries
+// may be printed in a single line.
+const maxLogLineSize = 16 * 1024 * 1024
+
+// parseTestOutput extracts the metrics of the job from the tests in the log of a build.
+// The JSON of a metric starts at the first brace after its marker and ends when the braces are balanced.
+// An error is returned if the log can't be read to the end.
+func parseTestOutput(scanner *bufio.Scanner, buildNumber int, job *JobConfig) (MetricToTestHistogram, error) {
+	result := MetricToTestHistogram{}
+	for _, metric := range job.Metrics {
+		result[metric] = TestToHistogram{}
+	}
 	state := scanning
 	testNameSeparator := "[It] [Feature:Performance]"
 	testName := ""
+	metric := ""
+	var reader *jsonreader.Reader
 	for scanner.Scan() {
 		line := scanner.Text()
 		if strings.Contains(line, testNameSeparator) {
 			state = inTest
-			var ok bool
-			testName, ok = descriptionToName[strings.Trim(strings.Split(line, testNameSeparator)[1], " ")]
-			if !ok {
-				testName = "Unknown"
+			testName = job.TestName(strings.Trim(strings.Split(line, testNameSeparator)[1], " "))
+			for _, hist := range result {
+				hist[testName] = make(ResourceToHistogram)
 			}
-			hist[testName] = make(ResourceToHistogram)
+			continue
 		}
-		if state == processing {
-			// TODO: This is brittle, we should emit a tail delimiter too
-			if strings.Contains(line, "INFO") || strings.Contains(line, "STEP") || strings.Contains(line, "Failure") || strings.Contains(line, "[AfterEach]") {
-				obj := LatencyData{}
-				if err := json.Unmarshal(buff.Bytes(), &obj); err != nil {
-					fmt.Printf("error parsing JSON in build %d: %v %s\n", buildNumber, err, buff.String())
-					// reset state and try again with more input
-					state = scanning
-					continue
+		if state == inTest {
+			for _, m := range job.Metrics {
+				marker := metricParsers[m].marker
+				if i := strings.Index(line, marker); i != -1 {
+					state = processing
+					metric = m
+					reader = &jsonreader.Reader{}
+					line = line[i+len(marker):]
+					break
 				}
-
-				for _, call := range obj.APICalls {
-					hist[testName][call.Resource] = append(hist[testName][call.Resource], call)
-					resources.Insert(call.Resource)
-					methods.Insert(call.Verb)
-				}
-
-				buff.Reset()
-				state = scanning
 			}
+			if state != processing {
+				continue
+			}
+		}
+		if state != processing {
+			continue
+		}
+		if !reader.Started() {
+			i := strings.Index(line, "{")
+			if i == -1 {
+				continue
+			}
+			line = line[i:]
 		}
-		if state == inTest && strings.Contains(line, "API calls latencies") {
-			state = processing
-			line = line[strings.Index(line, "{"):]
+		if !reader.Add(line) {
+			continue
 		}
-		if state == processing {
-			buff.WriteString(line + " ")
+		data, err := metricParsers[metric].parse(reader.Bytes())
+		if err != nil {
+			fmt.Printf("error parsing %v JSON in build %d: %v %s\n", metric, buildNumber, err, reader.String())
+		} else {
+			result[metric][testName] = data
 		}
+		state = inTest
 	}
-	return hist
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func getLatencyData(client *jenkins.JenkinsClient, job string) (TestToBuildData, sets.String, sets.String, error) {
-	buildLatency := TestToBuildData{}
-	resources := sets.NewString()
-	methods := sets.NewString()
-
-	queue, err := client.GetJob(job)
+// fetchNewBuilds parses the logs of the completed builds of the job that aren't in the store yet
+// and adds them to the store, returning the number of added builds. Builds beyond the newest
+// *maxBuilds are removed from the store.
+func fetchNewBuilds(client *jenkins.JenkinsClient, store BuildStore, job *JobConfig) (int, error) {
+	queue, err := client.GetJob(job.Name)
+	if err != nil {
+		return 0, err
+	}
+	stored, err := store.Builds(job.Name)
 	if err != nil {
-		return buildLatency, resources, methods, err
+		return 0, err
 	}
+	newBuilds := buildsToFetch(queue, stored)
 
-	for ix := range queue.Builds {
-		build := queue.Builds[ix]
-		if build.Number < *startFrom {
-			continue
-		}
-		reader, err := client.GetConsoleLog(job, build.Number)
+	added := 0
+	for _, build := range newBuilds {
+		reader, err := client.GetConsoleLog(job.Name, build)
 		if err != nil {
+			// The build isn't stored, so it is retried in the next poll.
 			fmt.Printf("error getting logs: %v\n", err)
 			continue
 		}
-		defer reader.Close()
 		scanner := bufio.NewScanner(reader)
-		hist := parseTestOutput(scanner, build.Number, resources, methods)
+		scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), maxLogLineSize)
+		data, err := parseTestOutput(scanner, build, job)
+		reader.Close()
+		if err != nil {
+			// The build isn't stored from a partial log, so it is retried in the next poll.
+			fmt.Printf("error reading logs of build %d: %v\n", build, err)
+			continue
+		}
+		if err := store.Put(job.Name, build, data); err != nil {
+			return added, err
+		}
+		added++
+	}
+	return added, pruneBuilds(store, job.Name)
+}
 
-		for k, v := range hist {
-			if _, ok := buildLatency[k]; !ok {
-				buildLatency[k] = make(BuildLatencyData)
-			}
-			buildLatency[k][fmt.Sprintf("%d", build.Number)] = v
+// buildsToFetch returns the completed builds of the queue that aren't stored, newest first. Only
+// the newest *maxBuilds completed builds are considered, older ones would be pruned right away.
+func buildsToFetch(queue *jenkins.Queue, stored []int) []int {
+	completed := []int{}
+	for _, build := range queue.Builds {
+		// The log of a running build is incomplete, it is fetched once the build completes.
+		if build.Number < *startFrom || build.Number > queue.LastCompletedBuild.Number {
+			continue
+		}
+		completed = append(completed, build.Number)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(completed)))
+	if *maxBuilds > 0 && len(completed) > *maxBuilds {
+		completed = completed[:*maxBuilds]
+	}
+	known := sets.NewInt(stored...)
+	newBuilds := []int{}
+	for _, build := range completed {
+		if !known.Has(build) {
+			newBuilds = append(newBuilds, build)
 		}
 	}
-	return buildLatency, resources, methods, nil
+	return newBuilds
 }
 
-func generateCSV(buildLatency BuildLatencyData, resources, methods sets.String, out io.Writer) error {
-	header := []string{"build"}
-	for _, rsrc := range resources.List() {
-		header = append(header, fmt.Sprintf("%s_50", rsrc))
-		header = append(header, fmt.Sprintf("%s_90", rsrc))
-		header = append(header, fmt.Sprintf("%s_99", rsrc))
+// pruneBuilds removes the oldest builds of the job from the store, keeping the newest *maxBuilds.
+func pruneBuilds(store BuildStore, job string) error {
+	if *maxBuilds <= 0 {
+		return nil
 	}
-	if _, err := fmt.Fprintln(out, strings.Join(header, ",")); err != nil {
+	builds, err := store.Builds(job)
+	if err != nil {
 		return err
 	}
-
-	for _, method := range methods.List() {
-		if _, err := fmt.Fprintln(out, method); err != nil {
+	for len(builds) > *maxBuilds {
+		if err := store.Delete(job, builds[0]); err != nil {
 			return err
 		}
-		for build, data := range buildLatency {
-			line := []string{fmt.Sprintf("%d", build)}
-			for _, rsrc := range resources.List() {
-				podData := data[rsrc]
-				line = append(line, fmt.Sprintf("%g", findMethod(method, "Perc50", podData)))
-				line = append(line, fmt.Sprintf("%g", findMethod(method, "Perc90", podData)))
-				line = append(line, fmt.Sprintf("%g", findMethod(method, "Perc99", podData)))
+		builds = builds[1:]
+	}
+	return nil
+}
+
+// getJobData reads the results of all the builds of the job in the store.
+func getJobData(store BuildStore, job string) (MetricToTestBuildData, error) {
+	jobData := MetricToTestBuildData{}
+	builds, err := store.Builds(job)
+	if err != nil {
+		return jobData, err
+	}
+	for _, build := range builds {
+		data, err := store.Get(job, build)
+		if err != nil {
+			return jobData, err
+		}
+		for metric, hist := range data {
+			if _, ok := jobData[metric]; !ok {
+				jobData[metric] = TestToBuildData{}
+			}
+			for k, v := range hist {
+				if _, ok := jobData[metric][k]; !ok {
+					jobData[metric][k] = make(BuildLatencyData)
+				}
+				jobData[metric][k][fmt.Sprintf("%d", build)] = v
+			}
+		}
+	}
+	return jobData, nil
+}
+
+// csvColumn is a column of the CSV export, the value of a percentile of a (resource, verb).
+type csvColumn struct {
+	resource, verb, percentile string
+}
+
+// generateCSV writes the data as a table with a row per test and build, sorted by build number,
+// and a column per resource, verb and percentile, e.g. "pods_GET_Perc99". Missing values are empty.
+// separator is ',' for CSV or '\t' for TSV.
+func generateCSV(buildLatency TestToBuildData, separator rune, out io.Writer) error {
+	columnSet := map[csvColumn]bool{}
+	for _, builds := range buildLatency {
+		for _, data := range builds {
+			for resource, calls := range data {
+				for _, call := range calls {
+					for percentile := range call.Latency {
+						columnSet[csvColumn{resource, call.Verb, percentile}] = true
+					}
+				}
+			}
+		}
+	}
+	columns := []csvColumn{}
+	for c := range columnSet {
+		columns = append(columns, c)
+	}
+	sort.Sort(csvColumnArr(columns))
+
+	writer := csv.NewWriter(out)
+	writer.Comma = separator
+	header := []string{"test", "build"}
+	for _, c := range columns {
+		header = append(header, fmt.Sprintf("%s_%s_%s", c.resource, c.verb, c.percentile))
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	tests := []string{}
+	for test := range buildLatency {
+		tests = append(tests, test)
+	}
+	sort.Strings(tests)
+	for _, test := range tests {
+		for _, build := range sortedBuilds(buildLatency[test]) {
+			data := buildLatency[test][strconv.Itoa(build)]
+			line := []string{test, strconv.Itoa(build)}
+			for _, c := range columns {
+				value := ""
+				if v, ok := findMethod(c.verb, c.percentile, data[c.resource]); ok {
+					value = strconv.FormatFloat(v, 'g', -1, 64)
+				}
+				line = append(line, value)
 			}
-			if _, err := fmt.Fprintln(out, strings.Join(line, ",")); err != nil {
+			if err := writer.Write(line); err != nil {
 				return err
 			}
 		}
 	}
-	return nil
+	writer.Flush()
+	return writer.Error()
+}
+
+type csvColumnArr []csvColumn
+
+func (a csvColumnArr) Len() int      { return len(a) }
+func (a csvColumnArr) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a csvColumnArr) Less(i, j int) bool {
+	if a[i].resource != a[j].resource {
+		return a[i].resource < a[j].resource
+	}
+	if a[i].verb != a[j].verb {
+		return a[i].verb < a[j].verb
+	}
+	return a[i].percentile < a[j].percentile
+}
+
+// detectRegressions looks for regressions in all the metrics of the job.
+func detectRegressions(job string, jobData MetricToTestBuildData) []Regression {
+	detector := &RegressionDetector{
+		Threshold:         *regressionThreshold,
+		ConsecutiveBuilds: *regressionBuilds,
+		BaselineBuilds:    *regressionBaseline,
+	}
+	regressions := []Regression{}
+	for metric, data := range jobData {
+		regressions = append(regressions, detector.Detect(job, metric, data)...)
+	}
+	sort.Sort(regressionArr(regressions))
+	return regressions
 }
 
-func findMethod(method, item string, data []APICallLatency) float64 {
+// findMethod returns the value of the percentile for the verb in the data, if any.
+func findMethod(method, item string, data []APICallLatency) (float64, bool) {
 	for _, datum := range data {
 		if datum.Verb == method {
-			return datum.Latency[item]
+			v, ok := datum.Latency[item]
+			return v, ok
 		}
 	}
-	return -1
+	return 0, false
 }
 
 var (
@@ -214,6 +454,17 @@ var (
 	wwwDir      = flag.String("dir", "", "If non-empty, add a file server for this directory at the root of the web server")
 	jenkinsHost = flag.String("jenkins-host", "", "The URL for the jenkins server.")
 	startFrom   = flag.Int("start-from", 0, "First build number to include in the results")
+	storageDir  = flag.String("storage-dir", "", "If non-empty, the results of the builds are stored as JSON files in this directory and kept across restarts. Otherwise they are kept in memory")
+	maxBuilds   = flag.Int("max-builds", 500, "Maximum number of builds kept for each job, the oldest ones are removed first. 0 means no limit")
+	csvFormat   = flag.String("format", csvFormatName, "Format of the data printed when -www is false: csv or tsv")
+	configFile  = flag.String("config", "", "If non-empty, a JSON file with the jobs to track and the metrics extracted from them. By default the scalability job is tracked")
+
+	regressionThreshold = flag.Float64("regression-threshold", 0.5, "A value regresses when it is more than this fraction above its baseline, e.g. 0.5 means 150% of the baseline")
+	regressionBuilds    = flag.Int("regression-builds", 3, "Number of consecutive latest builds that have to be above the threshold to report a regression")
+	regressionBaseline  = flag.Int("regression-baseline", 10, "Number of builds before the regressed ones whose median is the baseline")
+	regressionWebhook   = flag.String("regression-webhook", "", "If non-empty, new regressions are posted as JSON to this URL")
+
+	config = DefaultConfig()
 
 	pollDuration = 10 * time.Minute
 	errorDelay   = 10 * time.Second
@@ -221,40 +472,102 @@ var (
 
 func main() {
 	flag.Parse()
-	job := "kubernetes-e2e-gce-scalability"
+	if *regressionBuilds < 1 || *regressionBaseline < 1 {
+		fmt.Printf("-regression-builds and -regression-baseline must be at least 1\n")
+		os.Exit(1)
+	}
+	if *configFile != "" {
+		var err error
+		if config, err = LoadConfig(*configFile); err != nil {
+			fmt.Printf("Failed to read config: %v\n", err)
+			os.Exit(1)
+		}
+	}
 	client := &jenkins.JenkinsClient{
 		Host: *jenkinsHost,
 	}
-
-	if !*www {
-		buildLatency, resources, methods, err := getLatencyData(client, job)
-		if err != nil {
-			fmt.Printf("Failed to get data: %v\n", err)
+	store := NewMemoryStore()
+	if *storageDir != "" {
+		var err error
+		if store, err = NewFileStore(*storageDir); err != nil {
+			fmt.Printf("Failed to create storage: %v\n", err)
 			os.Exit(1)
 		}
-		for _, v := range buildLatency {
-			generateCSV(v, resources, methods, os.Stdout)
-		}
-		return
 	}
 
-	buildLatency := TestToBuildData{}
-	resources := sets.String{}
-	methods := sets.String{}
-	var err error
-	go func() {
-		for {
-			buildLatency, resources, methods, err = getLatencyData(client, job)
+	if !*www {
+		for i := range config.Jobs {
+			job := &config.Jobs[i]
+			if _, err := fetchNewBuilds(client, store, job); err != nil {
+				fmt.Printf("Failed to get data: %v\n", err)
+				os.Exit(1)
+			}
+			jobData, err := getJobData(store, job.Name)
 			if err != nil {
-				fmt.Printf("Error fetching data: %v\n", err)
-				time.Sleep(errorDelay)
-				continue
+				fmt.Printf("Failed to get data: %v\n", err)
+				os.Exit(1)
+			}
+			separator, err := csvSeparator(*csvFormat)
+			if err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+			if err := generateCSV(jobData[APICallLatencyMetric], separator, os.Stdout); err != nil {
+				fmt.Printf("Failed to write data: %v\n", err)
+				os.Exit(1)
 			}
-			time.Sleep(pollDuration)
 		}
-	}()
+		return
+	}
+
+	server := &DataServer{defaultJob: config.Jobs[0].Name}
+	for i := range config.Jobs {
+		job := &config.Jobs[i]
+		// Serve what is already stored while the new builds are fetched.
+		if jobData, err := getJobData(store, job.Name); err == nil {
+			server.SetJobData(job.Name, jobData)
+			server.SetRegressions(job.Name, detectRegressions(job.Name, jobData))
+		} else {
+			fmt.Printf("Error reading stored data of %v: %v\n", job.Name, err)
+		}
+		go func() {
+			for {
+				added, err := fetchNewBuilds(client, store, job)
+				if err != nil {
+					fmt.Printf("Error fetching data of %v: %v\n", job.Name, err)
+					time.Sleep(errorDelay)
+					continue
+				}
+				if added == 0 {
+					time.Sleep(pollDuration)
+					continue
+				}
+				jobData, err := getJobData(store, job.Name)
+				if err != nil {
+					fmt.Printf("Error reading stored data of %v: %v\n", job.Name, err)
+					time.Sleep(errorDelay)
+					continue
+				}
+				server.SetJobData(job.Name, jobData)
+				regressions := server.SetRegressions(job.Name, detectRegressions(job.Name, jobData))
+				for _, r := range regressions {
+					fmt.Printf("Regression in %v: %v %v %v %v of %v went from %g to %g since build %d\n", r.Job, r.Metric, r.Resource, r.Verb, r.Percentile, r.Test, r.Baseline, r.Value, r.FirstBuild)
+				}
+				if len(regressions) != 0 && *regressionWebhook != "" {
+					if err := postRegressions(*regressionWebhook, regressions); err != nil {
+						fmt.Printf("Error posting regressions: %v\n", err)
+					}
+				}
+				time.Sleep(pollDuration)
+			}
+		}()
+	}
 
-	http.Handle("/api", &buildLatency)
+	http.Handle("/api", server)
+	http.HandleFunc("/jobs", server.ServeJobs)
+	http.HandleFunc("/regressions", server.ServeRegressions)
+	http.HandleFunc("/query", server.ServeQuery)
+	http.HandleFunc("/export", server.ServeExport)
 	http.Handle("/", http.FileServer(http.Dir(*wwwDir)))
 	http.ListenAndServe(*addr, nil)
 }
@@ -0,0 +1,161 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"k8s.io/contrib/mungegithub/mungers/jenkins"
+)
+
+// setMaxBuilds sets the --max-builds flag and returns a function restoring it.
+func setMaxBuilds(n int) func() {
+	previous := *maxBuilds
+	*maxBuilds = n
+	return func() { *maxBuilds = previous }
+}
+
+func jenkinsQueue(lastCompleted int, numbers ...int) *jenkins.Queue {
+	queue := &jenkins.Queue{LastCompletedBuild: jenkins.Build{Number: lastCompleted}}
+	for _, n := range numbers {
+		queue.Builds = append(queue.Builds, jenkins.Build{Number: n})
+	}
+	return queue
+}
+
+func TestBuildsToFetch(t *testing.T) {
+	defer setMaxBuilds(3)()
+	cases := []struct {
+		queue    *jenkins.Queue
+		stored   []int
+		expected []int
+	}{
+		// the running build 8 is fetched once it completes
+		{jenkinsQueue(7, 8, 7, 6, 5, 4), nil, []int{7, 6, 5}},
+		{jenkinsQueue(7, 8, 7, 6, 5, 4), []int{5, 6}, []int{7}},
+		// the store is full, older builds would be pruned right away
+		{jenkinsQueue(7, 8, 7, 6, 5, 4, 3, 2, 1), []int{5, 6, 7}, []int{}},
+		{jenkinsQueue(8, 8, 7, 6, 5, 4, 3, 2, 1), []int{5, 6, 7}, []int{8}},
+		// a build that wasn't fetched is retried while it is one of the newest
+		{jenkinsQueue(8, 8, 7, 6, 5), []int{5, 6, 8}, []int{7}},
+	}
+	for i, test := range cases {
+		if result := buildsToFetch(test.queue, test.stored); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("case %d: expected %v, got %v", i, test.expected, result)
+		}
+	}
+}
+
+func TestPruneBuilds(t *testing.T) {
+	defer setMaxBuilds(2)()
+	store := NewMemoryStore()
+	for _, build := range []int{3, 10, 2, 9} {
+		store.Put("job", build, MetricToTestHistogram{})
+	}
+	if err := pruneBuilds(store, "job"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if builds, _ := store.Builds("job"); !reflect.DeepEqual(builds, []int{9, 10}) {
+		t.Errorf("expected the newest builds [9 10] to be kept, got %v", builds)
+	}
+}
+
+func TestFetchNewBuilds(t *testing.T) {
+	defer setMaxBuilds(3)()
+	var lock sync.Mutex
+	queue := jenkinsQueue(8, 8, 7, 6, 5, 4, 3, 2, 1)
+	fetched := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if req.URL.Path == "/job/job/api/json" {
+			json.NewEncoder(res).Encode(queue)
+			return
+		}
+		if !strings.HasSuffix(req.URL.Path, "/consoleText") {
+			res.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fetched = append(fetched, req.URL.Path)
+		fmt.Fprintln(res, "[It] [Feature:Performance] should allow starting 30 pods per node")
+	}))
+	defer server.Close()
+	client := &jenkins.JenkinsClient{Host: server.URL}
+	store := NewMemoryStore()
+	job := &JobConfig{Name: "job", Metrics: []string{APICallLatencyMetric}}
+
+	if added, err := fetchNewBuilds(client, store, job); err != nil || added != 3 {
+		t.Errorf("expected 3 builds to be added, got %d (%v)", added, err)
+	}
+	expected := []string{"/job/job/8/consoleText", "/job/job/7/consoleText", "/job/job/6/consoleText"}
+	if !reflect.DeepEqual(fetched, expected) {
+		t.Errorf("expected %v to be fetched, got %v", expected, fetched)
+	}
+
+	// the pruned builds aren't downloaded again
+	lock.Lock()
+	queue = jenkinsQueue(9, 9, 8, 7, 6, 5, 4, 3, 2, 1)
+	fetched = []string{}
+	lock.Unlock()
+	if added, err := fetchNewBuilds(client, store, job); err != nil || added != 1 {
+		t.Errorf("expected 1 build to be added, got %d (%v)", added, err)
+	}
+	if expected := []string{"/job/job/9/consoleText"}; !reflect.DeepEqual(fetched, expected) {
+		t.Errorf("expected %v to be fetched, got %v", expected, fetched)
+	}
+	if builds, _ := store.Builds("job"); !reflect.DeepEqual(builds, []int{7, 8, 9}) {
+		t.Errorf("expected builds [7 8 9], got %v", builds)
+	}
+}
+
+func TestFetchNewBuildsReadErrors(t *testing.T) {
+	defer setMaxBuilds(0)()
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/job/job/api/json":
+			json.NewEncoder(res).Encode(jenkinsQueue(2, 2, 1))
+		case "/job/job/1/consoleText":
+			// a line longer than the default buffer of a bufio.Scanner
+			fmt.Fprintln(res, "[It] [Feature:Performance] should allow starting 30 pods per node")
+			fmt.Fprintln(res, strings.Repeat("x", 100*1024))
+		case "/job/job/2/consoleText":
+			// the download is truncated
+			res.Header().Set("Content-Length", "1000")
+			fmt.Fprintln(res, "[It] [Feature:Performance] should allow starting 30 pods per node")
+		default:
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := &jenkins.JenkinsClient{Host: server.URL}
+	store := NewMemoryStore()
+	job := &JobConfig{Name: "job", Metrics: []string{APICallLatencyMetric}}
+
+	if added, err := fetchNewBuilds(client, store, job); err != nil || added != 1 {
+		t.Errorf("expected 1 build to be added, got %d (%v)", added, err)
+	}
+	if builds, _ := store.Builds("job"); !reflect.DeepEqual(builds, []int{1}) {
+		t.Errorf("expected builds [1], got %v", builds)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+const (
+	csvFormatName = "csv"
+	tsvFormatName = "tsv"
+)
+
+// csvSeparator returns the separator of the values for the format.
+func csvSeparator(format string) (rune, error) {
+	switch format {
+	case csvFormatName:
+		return ',', nil
+	case tsvFormatName:
+		return '\t', nil
+	}
+	return 0, fmt.Errorf("unknown format %q, expected %v or %v", format, csvFormatName, tsvFormatName)
+}
+
+// Query selects a single series: the value of a percentile of a (test, resource, verb) in a
+// range of builds.
+type Query struct {
+	Job        string
+	Metric     string
+	Test       string
+	Resource   string
+	Verb       string
+	Percentile string
+	// From and To are the first and last builds included, 0 means no limit.
+	From int
+	To   int
+}
+
+// TimeSeries is the result of a Query, Values[i] is the value in Builds[i].
+type TimeSeries struct {
+	Job        string    `json:"job"`
+	Metric     string    `json:"metric"`
+	Test       string    `json:"test"`
+	Resource   string    `json:"resource"`
+	Verb       string    `json:"verb"`
+	Percentile string    `json:"percentile"`
+	Builds     []int     `json:"builds"`
+	Values     []float64 `json:"values"`
+}
+
+// parseQuery reads a Query from the parameters of a request. test, resource, verb and
+// percentile are required, from and to are optional build numbers.
+func parseQuery(params url.Values) (*Query, error) {
+	q := &Query{
+		Job:        params.Get("job"),
+		Metric:     params.Get("metric"),
+		Test:       params.Get("test"),
+		Resource:   params.Get("resource"),
+		Verb:       params.Get("verb"),
+		Percentile: params.Get("percentile"),
+	}
+	if q.Metric == "" {
+		q.Metric = APICallLatencyMetric
+	}
+	for _, name := range []string{"test", "resource", "verb", "percentile"} {
+		if params.Get(name) == "" {
+			return nil, fmt.Errorf("missing parameter %q", name)
+		}
+	}
+	for name, build := range map[string]*int{"from": &q.From, "to": &q.To} {
+		value := params.Get(name)
+		if value == "" {
+			continue
+		}
+		var err error
+		if *build, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("invalid build number %q in parameter %q", value, name)
+		}
+	}
+	if q.To != 0 && q.From > q.To {
+		return nil, fmt.Errorf("from (%d) is after to (%d)", q.From, q.To)
+	}
+	return q, nil
+}
+
+// Run extracts the series from the data of the job and metric of the query. Builds without a
+// value are skipped.
+func (q *Query) Run(data TestToBuildData) *TimeSeries {
+	result := &TimeSeries{
+		Job:        q.Job,
+		Metric:     q.Metric,
+		Test:       q.Test,
+		Resource:   q.Resource,
+		Verb:       q.Verb,
+		Percentile: q.Percentile,
+		Builds:     []int{},
+		Values:     []float64{},
+	}
+	builds := data[q.Test]
+	for _, build := range sortedBuilds(builds) {
+		if build < q.From || (q.To != 0 && build > q.To) {
+			continue
+		}
+		value, ok := findMethod(q.Verb, q.Percentile, builds[strconv.Itoa(build)][q.Resource])
+		if !ok {
+			continue
+		}
+		result.Builds = append(result.Builds, build)
+		result.Values = append(result.Values, value)
+	}
+	return result
+}
+
+// sortedBuilds returns the build numbers of the data in ascending order.
+func sortedBuilds(data BuildLatencyData) []int {
+	builds := []int{}
+	for key := range data {
+		build, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		builds = append(builds, build)
+	}
+	sort.Ints(builds)
+	return builds
+}
+
+// ServeQuery serves the TimeSeries selected by the parameters of the request, as JSON or, if the
+// "format" parameter is csv or tsv, as a table with build and value columns.
+func (d *DataServer) ServeQuery(res http.ResponseWriter, req *http.Request) {
+	q, err := parseQuery(req.URL.Query())
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if q.Job == "" {
+		q.Job = d.defaultJob
+	}
+	series := q.Run(d.getData(q.Job, q.Metric))
+
+	format := req.URL.Query().Get("format")
+	if format == "" {
+		serveJSON(res, series)
+		return
+	}
+	separator, err := csvSeparator(format)
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res.Header().Set("Content-type", "text/"+format)
+	writer := csv.NewWriter(res)
+	writer.Comma = separator
+	writer.Write([]string{"build", "value"})
+	for i := range series.Builds {
+		writer.Write([]string{strconv.Itoa(series.Builds[i]), strconv.FormatFloat(series.Values[i], 'g', -1, 64)})
+	}
+	writer.Flush()
+}
+
+// ServeExport serves all the data of the job and metric selected by the request as CSV, or TSV
+// if the "format" parameter is tsv.
+func (d *DataServer) ServeExport(res http.ResponseWriter, req *http.Request) {
+	format := req.URL.Query().Get("format")
+	if format == "" {
+		format = csvFormatName
+	}
+	separator, err := csvSeparator(format)
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res.Header().Set("Content-type", "text/"+format)
+	generateCSV(d.getData(req.URL.Query().Get("job"), req.URL.Query().Get("metric")), separator, res)
+}
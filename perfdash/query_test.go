@@ -0,0 +1,153 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestGenerateCSV(t *testing.T) {
+	data := TestToBuildData{
+		"Density": BuildLatencyData{
+			"10": ResourceToHistogram{
+				"pods": []APICallLatency{
+					{Resource: "pods", Verb: "GET", Latency: Histogram{"Perc50": 1, "Perc99": 2.5}},
+					{Resource: "pods", Verb: "LIST", Latency: Histogram{"Perc99": 30}},
+				},
+			},
+			"9": ResourceToHistogram{
+				"pods": []APICallLatency{
+					{Resource: "pods", Verb: "GET", Latency: Histogram{"Perc50": 3, "Perc99": 4}},
+				},
+			},
+		},
+		"Load": BuildLatencyData{
+			"9": ResourceToHistogram{
+				"nodes": []APICallLatency{
+					{Resource: "nodes", Verb: "GET", Latency: Histogram{"Perc99": 5}},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		separator rune
+		expected  string
+	}{
+		{
+			separator: ',',
+			expected: "test,build,nodes_GET_Perc99,pods_GET_Perc50,pods_GET_Perc99,pods_LIST_Perc99\n" +
+				"Density,9,,3,4,\n" +
+				"Density,10,,1,2.5,30\n" +
+				"Load,9,5,,,\n",
+		},
+		{
+			separator: '\t',
+			expected: "test\tbuild\tnodes_GET_Perc99\tpods_GET_Perc50\tpods_GET_Perc99\tpods_LIST_Perc99\n" +
+				"Density\t9\t\t3\t4\t\n" +
+				"Density\t10\t\t1\t2.5\t30\n" +
+				"Load\t9\t5\t\t\t\n",
+		},
+	}
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		if err := generateCSV(data, test.separator, out); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if out.String() != test.expected {
+			t.Errorf("expected:\n%v\ngot:\n%v", test.expected, out.String())
+		}
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		params   string
+		expected *Query
+	}{
+		{
+			params:   "test=Density&resource=pods&verb=GET&percentile=Perc99",
+			expected: &Query{Metric: APICallLatencyMetric, Test: "Density", Resource: "pods", Verb: "GET", Percentile: "Perc99"},
+		},
+		{
+			params:   "job=j&metric=ResourceUsage&test=Density&resource=etcd&verb=cpu&percentile=Perc90&from=3&to=7",
+			expected: &Query{Job: "j", Metric: ResourceUsageMetric, Test: "Density", Resource: "etcd", Verb: "cpu", Percentile: "Perc90", From: 3, To: 7},
+		},
+		{params: "resource=pods&verb=GET&percentile=Perc99"},
+		{params: "test=Density&resource=pods&verb=GET"},
+		{params: "test=Density&resource=pods&verb=GET&percentile=Perc99&from=x"},
+		{params: "test=Density&resource=pods&verb=GET&percentile=Perc99&from=7&to=3"},
+	}
+	for _, test := range tests {
+		params, err := url.ParseQuery(test.params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		q, err := parseQuery(params)
+		if test.expected == nil {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %+v", test.params, q)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.params, err)
+			continue
+		}
+		if !reflect.DeepEqual(q, test.expected) {
+			t.Errorf("%v: expected %+v, got %+v", test.params, test.expected, q)
+		}
+	}
+}
+
+func TestQueryRun(t *testing.T) {
+	data := buildData(10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20)
+	tests := []struct {
+		query  Query
+		builds []int
+		values []float64
+	}{
+		{
+			query:  Query{Test: "Density", Resource: "pods", Verb: "GET", Percentile: "Perc99", From: 8},
+			builds: []int{8, 9, 10, 11},
+			values: []float64{17, 18, 19, 20},
+		},
+		{
+			query:  Query{Test: "Density", Resource: "pods", Verb: "GET", Percentile: "Perc99", From: 2, To: 3},
+			builds: []int{2, 3},
+			values: []float64{11, 12},
+		},
+		{
+			query:  Query{Test: "Density", Resource: "pods", Verb: "GET", Percentile: "Perc50"},
+			builds: []int{},
+			values: []float64{},
+		},
+		{
+			query:  Query{Test: "Load", Resource: "pods", Verb: "GET", Percentile: "Perc99"},
+			builds: []int{},
+			values: []float64{},
+		},
+	}
+	for _, test := range tests {
+		series := test.query.Run(data)
+		if !reflect.DeepEqual(series.Builds, test.builds) || !reflect.DeepEqual(series.Values, test.values) {
+			t.Errorf("%+v: expected builds %v and values %v, got %v and %v", test.query, test.builds, test.values, series.Builds, series.Values)
+		}
+	}
+}
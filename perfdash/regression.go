@@ -0,0 +1,188 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Regression is a value of a metric that stayed above its baseline for the last builds of a job.
+type Regression struct {
+	Job        string `json:"job"`
+	Metric     string `json:"metric"`
+	Test       string `json:"test"`
+	Resource   string `json:"resource"`
+	Verb       string `json:"verb"`
+	Percentile string `json:"percentile"`
+	// FirstBuild is the first of the regressed builds and LastBuild the latest one
+	FirstBuild int `json:"firstBuild"`
+	LastBuild  int `json:"lastBuild"`
+	// Baseline is the median of the builds before FirstBuild
+	Baseline float64 `json:"baseline"`
+	// Value is the value in LastBuild
+	Value float64 `json:"value"`
+}
+
+// key identifies the series of the regression. It doesn't include the builds, which move with
+// the latest builds while the regression lasts.
+func (r *Regression) key() string {
+	return fmt.Sprintf("%v/%v/%v/%v/%v/%v", r.Job, r.Metric, r.Test, r.Resource, r.Verb, r.Percentile)
+}
+
+type regressionArr []Regression
+
+func (a regressionArr) Len() int           { return len(a) }
+func (a regressionArr) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a regressionArr) Less(i, j int) bool { return a[i].key() < a[j].key() }
+
+// RegressionDetector looks for values that are above their rolling baseline.
+type RegressionDetector struct {
+	// Threshold is the allowed increase over the baseline, e.g. 0.5 allows values up to 150% of it.
+	Threshold float64
+	// ConsecutiveBuilds is the number of latest builds that have to cross the threshold.
+	ConsecutiveBuilds int
+	// BaselineBuilds is the number of builds before the regressed ones used as baseline.
+	BaselineBuilds int
+}
+
+// seriesValue is the value of a series in a build.
+type seriesValue struct {
+	build int
+	value float64
+}
+
+// Detect returns the series of the data that regressed in its latest builds. A series is the value
+// of a percentile for a (test, resource, verb); builds where it is missing are ignored. Nothing is
+// detected if ConsecutiveBuilds or BaselineBuilds is less than 1.
+func (d *RegressionDetector) Detect(job, metric string, data TestToBuildData) []Regression {
+	if d.ConsecutiveBuilds < 1 || d.BaselineBuilds < 1 {
+		return nil
+	}
+	type seriesKey struct {
+		test, resource, verb, percentile string
+	}
+	series := map[seriesKey][]seriesValue{}
+	for test, builds := range data {
+		for buildName, resources := range builds {
+			build, err := strconv.Atoi(buildName)
+			if err != nil {
+				continue
+			}
+			for resource, calls := range resources {
+				for _, call := range calls {
+					for percentile, value := range call.Latency {
+						key := seriesKey{test, resource, call.Verb, percentile}
+						series[key] = append(series[key], seriesValue{build, value})
+					}
+				}
+			}
+		}
+	}
+
+	regressions := []Regression{}
+	for key, values := range series {
+		sort.Sort(seriesValueArr(values))
+		if len(values) < d.ConsecutiveBuilds+d.BaselineBuilds {
+			continue
+		}
+		recent := values[len(values)-d.ConsecutiveBuilds:]
+		baseline := median(values[len(values)-d.ConsecutiveBuilds-d.BaselineBuilds : len(values)-d.ConsecutiveBuilds])
+		if baseline <= 0 {
+			continue
+		}
+		regressed := true
+		for _, v := range recent {
+			if v.value <= baseline*(1+d.Threshold) {
+				regressed = false
+				break
+			}
+		}
+		if !regressed {
+			continue
+		}
+		regressions = append(regressions, Regression{
+			Job:        job,
+			Metric:     metric,
+			Test:       key.test,
+			Resource:   key.resource,
+			Verb:       key.verb,
+			Percentile: key.percentile,
+			FirstBuild: recent[0].build,
+			LastBuild:  recent[len(recent)-1].build,
+			Baseline:   baseline,
+			Value:      recent[len(recent)-1].value,
+		})
+	}
+	sort.Sort(regressionArr(regressions))
+	return regressions
+}
+
+type seriesValueArr []seriesValue
+
+func (a seriesValueArr) Len() int           { return len(a) }
+func (a seriesValueArr) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a seriesValueArr) Less(i, j int) bool { return a[i].build < a[j].build }
+
+func median(values []seriesValue) float64 {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		sorted = append(sorted, v.value)
+	}
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// newRegressions returns the regressions in current that aren't in previous.
+func newRegressions(previous, current []Regression) []Regression {
+	known := map[string]bool{}
+	for i := range previous {
+		known[previous[i].key()] = true
+	}
+	result := []Regression{}
+	for i := range current {
+		if !known[current[i].key()] {
+			result = append(result, current[i])
+		}
+	}
+	return result
+}
+
+// postRegressions sends the regressions to the webhook as a JSON object with a "regressions" list.
+func postRegressions(url string, regressions []Regression) error {
+	body, err := json.Marshal(map[string][]Regression{"regressions": regressions})
+	if err != nil {
+		return err
+	}
+	res, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %v posting regressions to %v", res.Status, url)
+	}
+	return nil
+}
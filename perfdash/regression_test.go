@@ -0,0 +1,182 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// buildData creates the data of the test "Density" with the given Perc99 latencies of GET pods,
+// the first value is the one of build 1.
+func buildData(values ...float64) TestToBuildData {
+	builds := BuildLatencyData{}
+	for i, v := range values {
+		builds[fmt.Sprintf("%d", i+1)] = ResourceToHistogram{
+			"pods": []APICallLatency{
+				{Resource: "pods", Verb: "GET", Latency: Histogram{"Perc99": v}},
+			},
+		}
+	}
+	return TestToBuildData{"Density": builds}
+}
+
+func TestDetect(t *testing.T) {
+	detector := &RegressionDetector{Threshold: 0.5, ConsecutiveBuilds: 2, BaselineBuilds: 3}
+	tests := []struct {
+		name     string
+		data     TestToBuildData
+		expected []Regression
+	}{
+		{
+			name:     "stable",
+			data:     buildData(10, 11, 9, 10, 12),
+			expected: []Regression{},
+		},
+		{
+			name: "regression",
+			data: buildData(10, 11, 9, 16, 20),
+			expected: []Regression{
+				{
+					Job:        "job",
+					Metric:     APICallLatencyMetric,
+					Test:       "Density",
+					Resource:   "pods",
+					Verb:       "GET",
+					Percentile: "Perc99",
+					FirstBuild: 4,
+					LastBuild:  5,
+					Baseline:   10,
+					Value:      20,
+				},
+			},
+		},
+		{
+			name:     "spike in a single build",
+			data:     buildData(10, 11, 9, 10, 20),
+			expected: []Regression{},
+		},
+		{
+			name:     "recovered",
+			data:     buildData(10, 11, 9, 20, 20, 10),
+			expected: []Regression{},
+		},
+		{
+			name:     "not enough builds",
+			data:     buildData(10, 20, 20),
+			expected: []Regression{},
+		},
+		{
+			name: "baseline is the window before the regressed builds",
+			data: buildData(100, 100, 10, 11, 9, 16, 20),
+			expected: []Regression{
+				{
+					Job:        "job",
+					Metric:     APICallLatencyMetric,
+					Test:       "Density",
+					Resource:   "pods",
+					Verb:       "GET",
+					Percentile: "Perc99",
+					FirstBuild: 6,
+					LastBuild:  7,
+					Baseline:   10,
+					Value:      20,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		regressions := detector.Detect("job", APICallLatencyMetric, test.data)
+		if !reflect.DeepEqual(regressions, test.expected) {
+			t.Errorf("%v: expected %+v, got %+v", test.name, test.expected, regressions)
+		}
+	}
+}
+
+func TestDetectOrdersBuildsNumerically(t *testing.T) {
+	detector := &RegressionDetector{Threshold: 0.5, ConsecutiveBuilds: 1, BaselineBuilds: 9}
+	// Sorted as strings, build 10 would be the second one.
+	data := buildData(10, 10, 10, 10, 10, 10, 10, 10, 10, 30)
+	regressions := detector.Detect("job", APICallLatencyMetric, data)
+	if len(regressions) != 1 || regressions[0].FirstBuild != 10 {
+		t.Errorf("expected a regression in build 10, got %+v", regressions)
+	}
+}
+
+func TestNewRegressions(t *testing.T) {
+	known := Regression{Job: "job", Test: "Density", Resource: "pods", Verb: "GET", Percentile: "Perc99", FirstBuild: 4}
+	moved := known
+	moved.FirstBuild, moved.LastBuild = 5, 6
+	other := known
+	other.Verb = "LIST"
+	result := newRegressions([]Regression{known}, []Regression{moved, other})
+	if !reflect.DeepEqual(result, []Regression{other}) {
+		t.Errorf("expected only %+v to be new, got %+v", other, result)
+	}
+}
+
+func TestDetectPersistentRegression(t *testing.T) {
+	detector := &RegressionDetector{Threshold: 0.5, ConsecutiveBuilds: 2, BaselineBuilds: 3}
+	previous := detector.Detect("job", APICallLatencyMetric, buildData(10, 11, 9, 10, 16, 20))
+	if len(previous) != 1 {
+		t.Fatalf("expected a regression, got %+v", previous)
+	}
+	// the next build is still above the threshold
+	current := detector.Detect("job", APICallLatencyMetric, buildData(10, 11, 9, 10, 16, 20, 18))
+	if len(current) != 1 || current[0].FirstBuild == previous[0].FirstBuild {
+		t.Fatalf("expected the regression to move to the latest builds, got %+v", current)
+	}
+	if result := newRegressions(previous, current); len(result) != 0 {
+		t.Errorf("expected no new regression, got %+v", result)
+	}
+}
+
+func TestDetectInvalidBuilds(t *testing.T) {
+	data := buildData(10, 11, 9, 10, 16, 20)
+	for i, detector := range []RegressionDetector{
+		{Threshold: 0.5, ConsecutiveBuilds: 0, BaselineBuilds: 3},
+		{Threshold: 0.5, ConsecutiveBuilds: -1, BaselineBuilds: 3},
+		{Threshold: 0.5, ConsecutiveBuilds: 2, BaselineBuilds: 0},
+		{Threshold: 0.5, ConsecutiveBuilds: 2, BaselineBuilds: -5},
+	} {
+		if result := detector.Detect("job", APICallLatencyMetric, data); len(result) != 0 {
+			t.Errorf("case %d: expected no regressions, got %+v", i, result)
+		}
+	}
+}
+
+func TestPostRegressions(t *testing.T) {
+	received := map[string][]Regression{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if err := json.NewDecoder(req.Body).Decode(&received); err != nil {
+			t.Errorf("unexpected error decoding request: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	regressions := []Regression{{Job: "job", Test: "Density", FirstBuild: 4, Baseline: 10, Value: 20}}
+	if err := postRegressions(server.URL, regressions); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(received["regressions"], regressions) {
+		t.Errorf("expected %+v, got %+v", regressions, received)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BuildStore keeps the results parsed from the logs of builds, keyed by job and build number,
+// so builds are downloaded only once.
+type BuildStore interface {
+	// Builds returns the numbers of the builds stored for the job in ascending order.
+	Builds(job string) ([]int, error)
+	// Get returns the results of a build.
+	Get(job string, build int) (MetricToTestHistogram, error)
+	// Put stores the results of a build, replacing the existing ones.
+	Put(job string, build int, data MetricToTestHistogram) error
+	// Delete removes the results of a build.
+	Delete(job string, build int) error
+}
+
+// memoryStore is a BuildStore that keeps the results in memory, they are lost on restart.
+type memoryStore struct {
+	lock sync.Mutex
+	jobs map[string]map[int]MetricToTestHistogram
+}
+
+// NewMemoryStore creates a BuildStore that keeps the results in memory.
+func NewMemoryStore() BuildStore {
+	return &memoryStore{jobs: map[string]map[int]MetricToTestHistogram{}}
+}
+
+func (m *memoryStore) Builds(job string) ([]int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	builds := []int{}
+	for build := range m.jobs[job] {
+		builds = append(builds, build)
+	}
+	sort.Ints(builds)
+	return builds, nil
+}
+
+func (m *memoryStore) Get(job string, build int) (MetricToTestHistogram, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	data, ok := m.jobs[job][build]
+	if !ok {
+		return nil, fmt.Errorf("build %d of %v not found", build, job)
+	}
+	return data, nil
+}
+
+func (m *memoryStore) Put(job string, build int, data MetricToTestHistogram) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.jobs[job]; !ok {
+		m.jobs[job] = map[int]MetricToTestHistogram{}
+	}
+	m.jobs[job][build] = data
+	return nil
+}
+
+func (m *memoryStore) Delete(job string, build int) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.jobs[job], build)
+	return nil
+}
+
+// fileStore is a BuildStore that keeps the results of each build in a JSON file,
+// <dir>/<job>/<build>.json.
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore creates a BuildStore that keeps the results as JSON files in dir.
+func NewFileStore(dir string) (BuildStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (f *fileStore) path(job string, build int) string {
+	return filepath.Join(f.dir, job, fmt.Sprintf("%d.json", build))
+}
+
+func (f *fileStore) Builds(job string) ([]int, error) {
+	files, err := ioutil.ReadDir(filepath.Join(f.dir, job))
+	if os.IsNotExist(err) {
+		return []int{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	builds := []int{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		build, err := strconv.Atoi(strings.TrimSuffix(file.Name(), ".json"))
+		if err != nil {
+			continue
+		}
+		builds = append(builds, build)
+	}
+	sort.Ints(builds)
+	return builds, nil
+}
+
+func (f *fileStore) Get(job string, build int) (MetricToTestHistogram, error) {
+	data, err := ioutil.ReadFile(f.path(job, build))
+	if err != nil {
+		return nil, err
+	}
+	result := MetricToTestHistogram{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("error parsing %v: %v", f.path(job, build), err)
+	}
+	return result, nil
+}
+
+// Put writes the results to a temporary file and renames it, so a crash never leaves a
+// truncated file behind.
+func (f *fileStore) Put(job string, build int, data MetricToTestHistogram) error {
+	if err := os.MkdirAll(filepath.Join(f.dir, job), 0755); err != nil {
+		return err
+	}
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	path := f.path(job, build)
+	if err := ioutil.WriteFile(path+".tmp", content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func (f *fileStore) Delete(job string, build int) error {
+	err := os.Remove(f.path(job, build))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func testStore(t *testing.T, name string, store BuildStore) {
+	data := MetricToTestHistogram{
+		APICallLatencyMetric: TestToHistogram{
+			"Density": ResourceToHistogram{
+				"pods": []APICallLatency{{Resource: "pods", Verb: "GET", Latency: Histogram{"Perc99": 2.5}}},
+			},
+		},
+	}
+	if builds, err := store.Builds("job"); err != nil || len(builds) != 0 {
+		t.Errorf("%v: expected no builds, got %v (%v)", name, builds, err)
+	}
+	for _, build := range []int{10, 9, 100} {
+		if err := store.Put("job", build, data); err != nil {
+			t.Fatalf("%v: unexpected error: %v", name, err)
+		}
+	}
+	if err := store.Put("other", 1, data); err != nil {
+		t.Fatalf("%v: unexpected error: %v", name, err)
+	}
+	// the builds are sorted as numbers
+	if builds, err := store.Builds("job"); err != nil || !reflect.DeepEqual(builds, []int{9, 10, 100}) {
+		t.Errorf("%v: expected builds [9 10 100], got %v (%v)", name, builds, err)
+	}
+	if result, err := store.Get("job", 10); err != nil || !reflect.DeepEqual(result, data) {
+		t.Errorf("%v: expected %+v, got %+v (%v)", name, data, result, err)
+	}
+	if _, err := store.Get("job", 11); err == nil {
+		t.Errorf("%v: expected error getting a missing build", name)
+	}
+	if err := store.Delete("job", 10); err != nil {
+		t.Errorf("%v: unexpected error: %v", name, err)
+	}
+	// deleting a missing build isn't an error
+	if err := store.Delete("job", 10); err != nil {
+		t.Errorf("%v: unexpected error: %v", name, err)
+	}
+	if builds, err := store.Builds("job"); err != nil || !reflect.DeepEqual(builds, []int{9, 100}) {
+		t.Errorf("%v: expected builds [9 100], got %v (%v)", name, builds, err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, "memory", NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "perfdash")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testStore(t, "file", store)
+
+	// the builds are kept across restarts, files that aren't builds are ignored
+	if err := ioutil.WriteFile(filepath.Join(dir, "job", "notes.txt"), []byte("notes"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if builds, err := store.Builds("job"); err != nil || !reflect.DeepEqual(builds, []int{9, 100}) {
+		t.Errorf("expected builds [9 100], got %v (%v)", builds, err)
+	}
+}
@@ -21,27 +21,62 @@ var PerfDashApp = function(http, scope) {
     this.scope = scope;
     this.selectedResource = "pods";
     this.selectedVerb = "GET";
+    this.jobs = {};
+    this.jobNames = [];
+    this.selectedJob = null;
+    this.metrics = [];
+    this.selectedMetric = "APICallLatency";
     this.testNames = [];
     this.series = [ "Perc99", "Perc90", "Perc50" ];
     this.allData = null;
 };
 
+// Divisors applied to the values of each metric, API call latencies are in ns and shown in ms.
+var metricScale = {
+    "APICallLatency": 1000000
+};
+
 PerfDashApp.prototype.onClick = function(data) {
     console.log(data);
-    window.location = "http://kubekins.dls.corp.google.com/job/kubernetes-e2e-gce-scalability/" + data[0].label + "/"
+    window.location = "http://kubekins.dls.corp.google.com/job/" + this.selectedJob + "/" + data[0].label + "/"
 };
 
-// Fetch data from the server and update the data to display
+// Fetch the jobs and the metrics of each job, then the data of the selected ones
 PerfDashApp.prototype.refresh = function() {
-    this.http.get("api")
+    this.http.get("jobs")
+    .success(function(data) {
+        this.jobs = data;
+        this.jobNames = Object.keys(data).sort();
+        if (this.jobNames.indexOf(this.selectedJob) == -1) {
+            this.selectedJob = this.jobNames[0];
+        }
+        this.jobChanged();
+    }.bind(this))
+    .error(function(data) {
+        console.log("error fetching jobs");
+        console.log(data);
+    });
+};
+
+// Update the metrics of the selected job and fetch its data
+PerfDashApp.prototype.jobChanged = function() {
+    this.metrics = this.jobs[this.selectedJob] || [];
+    if (this.metrics.indexOf(this.selectedMetric) == -1) {
+        this.selectedMetric = this.metrics[0];
+    }
+    this.metricChanged();
+};
+
+// Fetch the data of the selected job and metric from the server and update the data to display
+PerfDashApp.prototype.metricChanged = function() {
+    this.http.get("api", {params: {job: this.selectedJob, metric: this.selectedMetric}})
     .success(function(data) {
         this.testNames = Object.keys(data);
-        this.testName = this.testNames[0];
+        if (this.testNames.indexOf(this.testName) == -1) {
+            this.testName = this.testNames[0];
+        }
         this.allData = data;
         this.testNameChanged();
-        this.labels = this.getLabels();
-        this.resources = this.getResources();
-        this.verbs = this.getVerbs();
     }.bind(this))
     .error(function(data) {
         console.log("error fetching api");
@@ -51,17 +86,28 @@ PerfDashApp.prototype.refresh = function() {
 
 // Update the data to graph, using the selected resource and verb
 PerfDashApp.prototype.resourceChanged = function() {
-    this.seriesData = [
-        this.getStream(this.getData(this.selectedResource, this.selectedVerb), "Perc99"),
-        this.getStream(this.getData(this.selectedResource, this.selectedVerb), "Perc90"),
-        this.getStream(this.getData(this.selectedResource, this.selectedVerb), "Perc50")
-    ];
+    var data = this.getData(this.selectedResource, this.selectedVerb);
+    this.series = this.getSeries(data);
+    this.seriesData = [];
+    angular.forEach(this.series, function(stream) {
+        this.seriesData.push(this.getStream(data, stream));
+    }.bind(this));
 };
 
 // Update the data to graph, using the selected testName
 PerfDashApp.prototype.testNameChanged = function() {
     this.data = this.allData[this.testName];
+    this.labels = this.getLabels();
+    this.resources = this.getResources();
+    if (this.resources.indexOf(this.selectedResource) == -1) {
+        this.selectedResource = this.resources[0];
+    }
     this.resourceChanged();
+    this.verbs = this.getVerbs();
+    if (this.verbs.indexOf(this.selectedVerb) == -1) {
+        this.selectedVerb = this.verbs[0];
+        this.resourceChanged();
+    }
 };
 
 // Get the set of all resources (e.g. 'pods') in the data set
@@ -79,14 +125,13 @@ PerfDashApp.prototype.getResources = function() {
     return result;
 };
 
-// Get the set of all verbs (e.g. 'GET') in the data set
+// Get the set of all verbs (e.g. 'GET') of the selected resource in the data set
 PerfDashApp.prototype.getVerbs = function() {
     var set = {};
+    var resource = this.selectedResource;
     angular.forEach(this.data, function(value, key) {
-        angular.forEach(value, function(v, k) {
-            angular.forEach(v, function(val) {
-                set[val.verb] = true;
-            });
+        angular.forEach(value[resource], function(val) {
+            set[val.verb] = true;
         });
     });
     var result = [];
@@ -122,19 +167,32 @@ PerfDashApp.prototype.getData = function(object, verb) {
     return result;
 };
 
+// Get the buckets (e.g. 'Perc90') present in a slice of data, highest first
+PerfDashApp.prototype.getSeries = function(data) {
+    var set = {};
+    angular.forEach(data, function(value) {
+        angular.forEach(value.latency, function(v, k) {
+            set[k] = true;
+        });
+    });
+    return Object.keys(set).sort().reverse();
+};
+
 // Given a slice of data, turn it into a time series of numbers
 // 'data' is an array of APICallLatency objects
 // 'stream' is a selector for latency data, (e.g. 'Perc50')
 PerfDashApp.prototype.getStream = function(data, stream) {
     var result = [];
+    var scale = metricScale[this.selectedMetric] || 1;
     angular.forEach(data, function(value) {
-        result.push(value.latency[stream] / 1000000);
+        result.push(value.latency[stream] / scale);
     });
     return result;
 };
 
 app.controller('AppCtrl', ['$scope', '$http', '$interval', function($scope, $http, $interval) {
     $scope.controller = new PerfDashApp($http, $scope);
+    $scope.controller.onClick = $scope.controller.onClick.bind($scope.controller);
     $scope.controller.refresh();
 
     // Refresh every 60 secs.  The data only refreshes every 10 minutes on the server